@@ -0,0 +1,102 @@
+package matchingclient
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/contract/goproto/matching"
+	"gameAppProject/param"
+	"gameAppProject/pkg/grpcmsg"
+	"gameAppProject/pkg/protobufmapper"
+	"gameAppProject/pkg/richerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Config struct {
+	Address string `koanf:"address"`
+}
+
+// Client calls the matching grpc server, the connection is established lazily and is shared between the calls.
+type Client struct {
+	conn   *grpc.ClientConn
+	client matching.MatchingServiceClient
+}
+
+func New(config Config) Client {
+	// TODO - use transport credentials when the services don't run in a private network
+	conn, err := grpc.Dial(config.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(fmt.Errorf("can't dial matching grpc server: %v", err))
+	}
+
+	return Client{conn: conn, client: matching.NewMatchingServiceClient(conn)}
+}
+
+func (c Client) AddToWaitingList(ctx context.Context, req param.AddToWaitingListRequest) (
+	param.AddToWaitingListResponse, error) {
+	const op = richerror.Op("matchingclient.AddToWaitingList")
+
+	res, err := c.client.AddToWaitingList(ctx, protobufmapper.MapAddToWaitingListRequestToProtobuf(req))
+	if err != nil {
+		return param.AddToWaitingListResponse{}, richerror.New(op).WithErr(err).
+			WithMessage(grpcmsg.Message(err)).WithKind(grpcmsg.Kind(err))
+	}
+
+	return protobufmapper.MapAddToWaitingListResponseFromProtobuf(res), nil
+}
+
+func (c Client) LeaveWaitingList(ctx context.Context, req param.LeaveWaitingListRequest) (
+	param.LeaveWaitingListResponse, error) {
+	const op = richerror.Op("matchingclient.LeaveWaitingList")
+
+	_, err := c.client.LeaveWaitingList(ctx, protobufmapper.MapLeaveWaitingListRequestToProtobuf(req))
+	if err != nil {
+		return param.LeaveWaitingListResponse{}, richerror.New(op).WithErr(err).
+			WithMessage(grpcmsg.Message(err)).WithKind(grpcmsg.Kind(err))
+	}
+
+	return param.LeaveWaitingListResponse{}, nil
+}
+
+func (c Client) GetWaitingStatus(ctx context.Context, req param.GetWaitingStatusRequest) (
+	param.GetWaitingStatusResponse, error) {
+	const op = richerror.Op("matchingclient.GetWaitingStatus")
+
+	res, err := c.client.GetWaitingStatus(ctx, protobufmapper.MapGetWaitingStatusRequestToProtobuf(req))
+	if err != nil {
+		return param.GetWaitingStatusResponse{}, richerror.New(op).WithErr(err).
+			WithMessage(grpcmsg.Message(err)).WithKind(grpcmsg.Kind(err))
+	}
+
+	return protobufmapper.MapGetWaitingStatusResponseFromProtobuf(res), nil
+}
+
+func (c Client) ClaimMatchedUsers(ctx context.Context, req param.ClaimMatchedUsersRequest) (
+	param.ClaimMatchedUsersResponse, error) {
+	const op = richerror.Op("matchingclient.ClaimMatchedUsers")
+
+	res, err := c.client.ClaimMatchedUsers(ctx, protobufmapper.MapClaimMatchedUsersRequestToProtobuf(req))
+	if err != nil {
+		return param.ClaimMatchedUsersResponse{}, richerror.New(op).WithErr(err).
+			WithMessage(grpcmsg.Message(err)).WithKind(grpcmsg.Kind(err))
+	}
+
+	return protobufmapper.MapClaimMatchedUsersResponseFromProtobuf(res, req.Category), nil
+}
+
+func (c Client) RestoreMatchedUsers(ctx context.Context, req param.RestoreMatchedUsersRequest) (
+	param.RestoreMatchedUsersResponse, error) {
+	const op = richerror.Op("matchingclient.RestoreMatchedUsers")
+
+	_, err := c.client.RestoreMatchedUsers(ctx, protobufmapper.MapRestoreMatchedUsersRequestToProtobuf(req))
+	if err != nil {
+		return param.RestoreMatchedUsersResponse{}, richerror.New(op).WithErr(err).
+			WithMessage(grpcmsg.Message(err)).WithKind(grpcmsg.Kind(err))
+	}
+
+	return param.RestoreMatchedUsersResponse{}, nil
+}
+
+func (c Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,61 @@
+package presenceclient
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/contract/goproto/presence"
+	"gameAppProject/param"
+	"gameAppProject/pkg/grpcmsg"
+	"gameAppProject/pkg/protobufmapper"
+	"gameAppProject/pkg/richerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Config struct {
+	Address string `koanf:"address"`
+}
+
+// Client calls the presence grpc server, the connection is established lazily and is shared between the calls.
+type Client struct {
+	conn   *grpc.ClientConn
+	client presence.PresenceServiceClient
+}
+
+func New(config Config) Client {
+	// TODO - use transport credentials when the services don't run in a private network
+	conn, err := grpc.Dial(config.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(fmt.Errorf("can't dial presence grpc server: %v", err))
+	}
+
+	return Client{conn: conn, client: presence.NewPresenceServiceClient(conn)}
+}
+
+func (c Client) Upsert(ctx context.Context, req param.UpsertPresenceRequest) (param.UpsertPresenceResponse, error) {
+	const op = richerror.Op("presenceclient.Upsert")
+
+	_, err := c.client.Upsert(ctx, protobufmapper.MapUpsertPresenceRequestToProtobuf(req))
+	if err != nil {
+		return param.UpsertPresenceResponse{}, richerror.New(op).WithErr(err).
+			WithMessage(grpcmsg.Message(err)).WithKind(grpcmsg.Kind(err))
+	}
+
+	return param.UpsertPresenceResponse{}, nil
+}
+
+func (c Client) GetPresence(ctx context.Context, req param.GetPresenceRequest) (param.GetPresenceResponse, error) {
+	const op = richerror.Op("presenceclient.GetPresence")
+
+	res, err := c.client.GetPresence(ctx, protobufmapper.MapGetPresenceRequestToProtobuf(req))
+	if err != nil {
+		return param.GetPresenceResponse{}, richerror.New(op).WithErr(err).
+			WithMessage(grpcmsg.Message(err)).WithKind(grpcmsg.Kind(err))
+	}
+
+	return protobufmapper.MapGetPresenceResponseFromProtobuf(res), nil
+}
+
+func (c Client) Close() error {
+	return c.conn.Close()
+}
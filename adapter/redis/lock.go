@@ -0,0 +1,108 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// releaseLockScript deletes the key only if it still holds our token,
+// so an expired lock that has been taken by another instance is not released by mistake.
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// refreshLockScript extends the ttl only if the key still holds our token.
+var refreshLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// Lock is a distributed lock that is held by one instance until it is released or its ttl is passed.
+type Lock struct {
+	client *redis.Client
+	key    string
+	token  string
+	ttl    time.Duration
+}
+
+// Lock tries to acquire the lock once, it returns false if another instance holds the lock.
+// the ttl must be at least a millisecond, a lock without a ttl would never expire if its holder crashes.
+func (a Adapter) Lock(ctx context.Context, key string, ttl time.Duration) (Lock, bool, error) {
+	if ttl < time.Millisecond {
+		return Lock{}, false, fmt.Errorf("lock ttl of %s must be at least 1ms, got %s", key, ttl)
+	}
+
+	token, err := newLockToken()
+	if err != nil {
+		return Lock{}, false, err
+	}
+
+	ok, err := a.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return Lock{}, false, err
+	}
+
+	if !ok {
+		return Lock{}, false, nil
+	}
+
+	return Lock{client: a.client, key: key, token: token, ttl: ttl}, true, nil
+}
+
+// Release frees the lock if it is still held by us.
+func (l Lock) Release(ctx context.Context) error {
+	return releaseLockScript.Run(ctx, l.client, []string{l.key}, l.token).Err()
+}
+
+// Refresh resets the ttl of the lock, it returns false if the lock is not held by us anymore.
+func (l Lock) Refresh(ctx context.Context) (bool, error) {
+	res, err := refreshLockScript.Run(ctx, l.client, []string{l.key}, l.token, l.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return res == 1, nil
+}
+
+// KeepAlive refreshes the lock every third of its ttl until ctx is done.
+// onLost is called once if the lock can't be refreshed anymore, the caller should stop its work then.
+func (l Lock) KeepAlive(ctx context.Context, onLost func()) {
+	go func() {
+		ticker := time.NewTicker(l.ttl / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				ok, err := l.Refresh(ctx)
+				if err != nil || !ok {
+					// TODO - log error
+					fmt.Println("redis lock lost", l.key, err)
+					onLost()
+
+					return
+				}
+			}
+		}
+	}()
+}
+
+func newLockToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,100 @@
+package redisnotifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gameAppProject/adapter/redis"
+	"gameAppProject/param"
+	goredis "github.com/redis/go-redis/v9"
+	"sync"
+)
+
+type Config struct {
+	Channel string `koanf:"channel"`
+}
+
+// LocalNotifier delivers the events to the connections of this instance, e.g. the websocket hub.
+type LocalNotifier interface {
+	Notify(userIDs []uint, event param.GameEvent)
+}
+
+// Notifier publishes the game events to all instances, every instance delivers them to its own connections,
+// so an event reaches the players wherever they are connected.
+type Notifier struct {
+	config Config
+	client *goredis.Client
+	local  LocalNotifier
+}
+
+// message keeps the payload as it's encoded, so it's sent to the players as it's published.
+type message struct {
+	UserIDs []uint              `json:"user_ids"`
+	Type    param.GameEventType `json:"type"`
+	Payload json.RawMessage     `json:"payload"`
+}
+
+func New(config Config, adapter redis.Adapter, local LocalNotifier) Notifier {
+	return Notifier{config: config, client: adapter.Client(), local: local}
+}
+
+// Notify is best effort like the hub, the event is only delivered locally when it can't be published.
+func (n Notifier) Notify(userIDs []uint, event param.GameEvent) {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		// TODO - log error
+		fmt.Println("redisnotifier.Notify marshal error", err)
+
+		return
+	}
+
+	data, err := json.Marshal(message{UserIDs: userIDs, Type: event.Type, Payload: payload})
+	if err != nil {
+		// TODO - log error
+		fmt.Println("redisnotifier.Notify marshal error", err)
+
+		return
+	}
+
+	if err := n.client.Publish(context.Background(), n.config.Channel, data).Err(); err != nil {
+		// TODO - log error
+		fmt.Println("redisnotifier.Notify publish error", err)
+
+		n.local.Notify(userIDs, event)
+	}
+}
+
+// Start delivers the published events to the local connections until ctx is done.
+func (n Notifier) Start(ctx context.Context, wg *sync.WaitGroup) {
+	pubsub := n.client.Subscribe(ctx, n.config.Channel)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		<-ctx.Done()
+
+		if err := pubsub.Close(); err != nil {
+			// TODO - log error
+			fmt.Println("redisnotifier pubsub close error", err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		// go-redis reconnects the subscription, the channel is closed by pubsub.Close
+		for msg := range pubsub.Channel() {
+			var m message
+			if err := json.Unmarshal([]byte(msg.Payload), &m); err != nil {
+				// TODO - log error
+				fmt.Println("redisnotifier unmarshal error", err)
+
+				continue
+			}
+
+			n.local.Notify(m.UserIDs, param.GameEvent{Type: m.Type, Payload: m.Payload})
+		}
+	}()
+}
@@ -0,0 +1,276 @@
+package redisstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gameAppProject/adapter/redis"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/event"
+	"gameAppProject/pkg/richerror"
+	goredis "github.com/redis/go-redis/v9"
+	"os"
+	"strings"
+	"time"
+)
+
+const eventField = "event"
+
+type Config struct {
+	Prefix string `koanf:"prefix"`
+	// the streams are trimmed to about MaxLen entries
+	MaxLen       int64         `koanf:"max_len"`
+	BatchSize    int64         `koanf:"batch_size"`
+	BlockTimeout time.Duration `koanf:"block_timeout"`
+	// the events that are not acked after ReclaimIdle are delivered again,
+	// they go to the dead letter stream after MaxDeliveries
+	ReclaimIdle     time.Duration `koanf:"reclaim_idle"`
+	ReclaimInterval time.Duration `koanf:"reclaim_interval"`
+	MaxDeliveries   int64         `koanf:"max_deliveries"`
+	// ConsumerName is unique per instance, it's the host name and the pid when it's empty
+	ConsumerName string `koanf:"consumer_name"`
+}
+
+// Bus keeps every event type in its own stream, e.g. events:game.started.
+type Bus struct {
+	config Config
+	client *goredis.Client
+}
+
+func New(config Config, adapter redis.Adapter) Bus {
+	if config.ConsumerName == "" {
+		hostname, _ := os.Hostname()
+		config.ConsumerName = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+
+	return Bus{config: config, client: adapter.Client()}
+}
+
+func (b Bus) Publish(ctx context.Context, events ...event.Event) error {
+	const op = richerror.Op("redisstream.Publish")
+
+	if _, err := b.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for _, e := range events {
+			data, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+
+			pipe.XAdd(ctx, &goredis.XAddArgs{
+				Stream: b.streamKey(e.Type),
+				MaxLen: b.config.MaxLen,
+				Approx: true,
+				Values: map[string]interface{}{eventField: data},
+			})
+		}
+
+		return nil
+	}); err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (b Bus) Subscribe(ctx context.Context, group string, eventTypes []entity.EventType, handler event.Handler) error {
+	const op = richerror.Op("redisstream.Subscribe")
+
+	streams := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		key := b.streamKey(t)
+
+		// a new group gets the events that are still in the stream too,
+		// so the events published before its first deployment are not lost
+		if err := b.client.XGroupCreateMkStream(ctx, key, group, "0").Err(); err != nil &&
+			!strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected).
+				WithMeta(map[string]interface{}{"stream": key, "group": group})
+		}
+
+		streams = append(streams, key)
+	}
+
+	// XREADGROUP takes the streams and then their ids, > is the new events of the group
+	args := append(append([]string{}, streams...), make([]string, len(streams))...)
+	for i := range streams {
+		args[len(streams)+i] = ">"
+	}
+
+	lastReclaim := time.Time{}
+
+	for ctx.Err() == nil {
+		if time.Since(lastReclaim) >= b.config.ReclaimInterval {
+			for _, stream := range streams {
+				b.reclaim(ctx, stream, group, handler)
+			}
+
+			lastReclaim = time.Now()
+		}
+
+		result, err := b.client.XReadGroup(ctx, &goredis.XReadGroupArgs{
+			Group:    group,
+			Consumer: b.config.ConsumerName,
+			Streams:  args,
+			Count:    b.config.BatchSize,
+			Block:    b.config.BlockTimeout,
+		}).Result()
+		if err != nil {
+			if err == goredis.Nil || ctx.Err() != nil {
+				continue
+			}
+
+			// TODO - log error
+			fmt.Println("redisstream XReadGroup error", err)
+
+			// redis may be down, don't retry in a busy loop
+			select {
+			case <-ctx.Done():
+			case <-time.After(b.config.BlockTimeout):
+			}
+
+			continue
+		}
+
+		for _, s := range result {
+			for _, m := range s.Messages {
+				b.handle(ctx, s.Stream, group, m, handler)
+			}
+		}
+	}
+
+	return nil
+}
+
+// handle acks the message when the handler succeeds, otherwise it stays pending and is reclaimed later.
+func (b Bus) handle(ctx context.Context, stream, group string, m goredis.XMessage, handler event.Handler) {
+	e, err := decodeMessage(m)
+	if err != nil {
+		// it can't be processed however many times it's delivered
+		b.deadLetter(ctx, stream, group, m, err.Error())
+
+		return
+	}
+
+	if err := handler(ctx, e); err != nil {
+		// TODO - log error
+		fmt.Println("redisstream handler error", stream, group, m.ID, err)
+
+		return
+	}
+
+	if err := b.client.XAck(ctx, stream, group, m.ID).Err(); err != nil {
+		// TODO - log error, the event is delivered again after ReclaimIdle
+		fmt.Println("redisstream XAck error", err)
+	}
+}
+
+// reclaim takes over the events that another consumer has not acked in time, e.g. it has crashed,
+// and moves the ones that have failed too many times to the dead letter stream.
+func (b Bus) reclaim(ctx context.Context, stream, group string, handler event.Handler) {
+	pending, err := b.client.XPendingExt(ctx, &goredis.XPendingExtArgs{
+		Stream: stream,
+		Group:  group,
+		Idle:   b.config.ReclaimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  b.config.BatchSize,
+	}).Result()
+	if err != nil {
+		// TODO - log error
+		fmt.Println("redisstream XPendingExt error", err)
+
+		return
+	}
+
+	retryIDs := make([]string, 0, len(pending))
+	for _, p := range pending {
+		if p.RetryCount < b.config.MaxDeliveries {
+			retryIDs = append(retryIDs, p.ID)
+
+			continue
+		}
+
+		messages, err := b.client.XRangeN(ctx, stream, p.ID, p.ID, 1).Result()
+		if err != nil {
+			// TODO - log error
+			fmt.Println("redisstream XRangeN error", err)
+
+			continue
+		}
+
+		if len(messages) == 0 {
+			// the stream has been trimmed, the event is lost anyway
+			b.client.XAck(ctx, stream, group, p.ID)
+
+			continue
+		}
+
+		b.deadLetter(ctx, stream, group, messages[0], fmt.Sprintf("delivered %d times", p.RetryCount))
+	}
+
+	if len(retryIDs) == 0 {
+		return
+	}
+
+	messages, err := b.client.XClaim(ctx, &goredis.XClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		Consumer: b.config.ConsumerName,
+		MinIdle:  b.config.ReclaimIdle,
+		Messages: retryIDs,
+	}).Result()
+	if err != nil {
+		// TODO - log error
+		fmt.Println("redisstream XClaim error", err)
+
+		return
+	}
+
+	for _, m := range messages {
+		b.handle(ctx, stream, group, m, handler)
+	}
+}
+
+func (b Bus) deadLetter(ctx context.Context, stream, group string, m goredis.XMessage, reason string) {
+	values := map[string]interface{}{
+		eventField: m.Values[eventField],
+		"id":       m.ID,
+		"group":    group,
+		"reason":   reason,
+	}
+
+	if err := b.client.XAdd(ctx, &goredis.XAddArgs{
+		Stream: stream + ":dead_letter",
+		MaxLen: b.config.MaxLen,
+		Approx: true,
+		Values: values,
+	}).Err(); err != nil {
+		// TODO - log error, the event stays pending and is moved on the next reclaim
+		fmt.Println("redisstream dead letter XAdd error", err)
+
+		return
+	}
+
+	if err := b.client.XAck(ctx, stream, group, m.ID).Err(); err != nil {
+		// TODO - log error
+		fmt.Println("redisstream XAck error", err)
+	}
+}
+
+func (b Bus) streamKey(eventType entity.EventType) string {
+	return fmt.Sprintf("%s:%s", b.config.Prefix, eventType)
+}
+
+func decodeMessage(m goredis.XMessage) (event.Event, error) {
+	data, ok := m.Values[eventField].(string)
+	if !ok {
+		return event.Event{}, fmt.Errorf("message %s has no %s field", m.ID, eventField)
+	}
+
+	var e event.Event
+	if err := json.Unmarshal([]byte(data), &e); err != nil {
+		return event.Event{}, err
+	}
+
+	return e, nil
+}
@@ -0,0 +1,47 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	// LogFilePath is the file that messages are appended to, messages are printed to stdout if it's empty
+	LogFilePath string `koanf:"log_file_path"`
+}
+
+// LogSender writes the messages instead of sending them, it's used when there is no sms provider.
+type LogSender struct {
+	config Config
+	mu     *sync.Mutex
+}
+
+func NewLogSender(config Config) LogSender {
+	return LogSender{config: config, mu: &sync.Mutex{}}
+}
+
+func (l LogSender) Send(_ context.Context, phoneNumber, message string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var w io.Writer = os.Stdout
+	if l.config.LogFilePath != "" {
+		f, err := os.OpenFile(l.config.LogFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return fmt.Errorf("can't open sms log file: %w", err)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	if _, err := fmt.Fprintf(w, "%s sms to %s: %s\n", time.Now().Format(time.RFC3339), phoneNumber, message); err != nil {
+		return fmt.Errorf("can't write sms log: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type Config struct {
+	// BasePath is the directory of the stored files
+	BasePath string `koanf:"base_path"`
+	// BaseURL is the url prefix that the files are served from
+	BaseURL string `koanf:"base_url"`
+}
+
+// LocalStorage keeps the files on the local disk, the http server serves them from BaseURL.
+type LocalStorage struct {
+	config Config
+}
+
+func NewLocalStorage(config Config) LocalStorage {
+	return LocalStorage{config: config}
+}
+
+func (l LocalStorage) Save(_ context.Context, key string, data []byte) error {
+	p := l.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return fmt.Errorf("can't create storage directory: %w", err)
+	}
+
+	if err := os.WriteFile(p, data, 0644); err != nil {
+		return fmt.Errorf("can't write file: %w", err)
+	}
+
+	return nil
+}
+
+func (l LocalStorage) Delete(_ context.Context, key string) error {
+	if err := os.Remove(l.path(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("can't delete file: %w", err)
+	}
+
+	return nil
+}
+
+func (l LocalStorage) URL(key string) string {
+	return strings.TrimSuffix(l.config.BaseURL, "/") + path.Clean("/"+key)
+}
+
+// path keeps the file inside the base path, even if the key has ".." in it.
+func (l LocalStorage) path(key string) string {
+	return filepath.Join(l.config.BasePath, filepath.FromSlash(path.Clean("/"+key)))
+}
@@ -3,23 +3,50 @@ package main
 import (
 	"context"
 	"fmt"
+	"gameAppProject/adapter/matchingclient"
 	"gameAppProject/adapter/redis"
+	"gameAppProject/adapter/redisnotifier"
+	"gameAppProject/adapter/redisstream"
+	"gameAppProject/adapter/sms"
+	"gameAppProject/adapter/storage"
 	"gameAppProject/config"
+	"gameAppProject/delivery/eventhandler"
 	"gameAppProject/delivery/httpserver"
+	"gameAppProject/delivery/wshub"
 	"gameAppProject/repository/migrator"
 	"gameAppProject/repository/mysql"
 	"gameAppProject/repository/mysql/mysqlaccesscontrol"
+	"gameAppProject/repository/mysql/mysqlaudit"
+	"gameAppProject/repository/mysql/mysqlgame"
+	"gameAppProject/repository/mysql/mysqlquestion"
+	"gameAppProject/repository/mysql/mysqlrating"
 	"gameAppProject/repository/mysql/mysqluser"
-	"gameAppProject/repository/redis/redismatching"
+	"gameAppProject/repository/redis/redisaccesscontrol"
+	"gameAppProject/repository/redis/redisauth"
+	"gameAppProject/repository/redis/redisloginguard"
+	"gameAppProject/repository/redis/redisotp"
+	"gameAppProject/repository/redis/redispresence"
+	"gameAppProject/repository/redis/redisratelimit"
 	"gameAppProject/service/authorizationservice"
 	"gameAppProject/service/authservice"
 	"gameAppProject/service/backofficeuserservice"
-	"gameAppProject/service/matchingservice"
+	"gameAppProject/service/gameservice"
+	"gameAppProject/service/loginguardservice"
+	"gameAppProject/service/otpservice"
+	"gameAppProject/service/presenceservice"
+	"gameAppProject/service/questionservice"
+	"gameAppProject/service/ratelimitservice"
+	"gameAppProject/service/ratingservice"
 	"gameAppProject/service/userservice"
+	"gameAppProject/validator/accesscontrolvalidator"
+	"gameAppProject/validator/backofficeuservalidator"
+	"gameAppProject/validator/gamevalidator"
 	"gameAppProject/validator/matchingvalidator"
+	"gameAppProject/validator/questionvalidator"
 	"gameAppProject/validator/uservalidator"
 	"os"
 	"os/signal"
+	"sync"
 )
 
 const (
@@ -36,13 +63,22 @@ func main() {
 	mgr.Up()
 
 	// TODO - add struct and add these returned items as struct field
-	authSvc, userSvc, userValidator, backofficeSvc, authorizationSvc, matchingSvc, matchingV := setupServices(cfg)
+	authSvc, userSvc, userValidator, backofficeSvc, backofficeV, authorizationSvc, accessControlV, matchingClient, matchingV,
+		presenceSvc, questionSvc, questionV, gameSvc, gameV, hub, gameNotifier, eventHandler := setupServices(cfg)
 
-	server := httpserver.New(cfg, authSvc, userSvc, userValidator, backofficeSvc, authorizationSvc, matchingSvc, matchingV)
+	server := httpserver.New(cfg, authSvc, userSvc, userValidator, backofficeSvc, backofficeV, authorizationSvc,
+		accessControlV, matchingClient, matchingV, presenceSvc, questionSvc, questionV, gameSvc, gameV, hub)
 	go func() {
 		server.Serve()
 	}()
 
+	var wg sync.WaitGroup
+
+	// the outbox is relayed by the scheduler, see cmd/scheduler
+	eventCtx, stopEvents := context.WithCancel(context.Background())
+	eventHandler.Start(eventCtx, &wg)
+	gameNotifier.Start(eventCtx, &wg)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
@@ -56,33 +92,85 @@ func main() {
 	}
 
 	fmt.Println("received interrupt signal, shutting down gracefully..")
+	stopEvents()
+
 	<-ctxWithTimeout.Done()
+
+	wg.Wait()
+
+	if err := matchingClient.Close(); err != nil {
+		fmt.Println("matching client close error", err)
+	}
 }
 
 func setupServices(cfg config.Config) (
 	authservice.Service, userservice.Service, uservalidator.Validator,
-	backofficeuserservice.Service, authorizationservice.Service,
-	matchingservice.Service, matchingvalidator.Validator,
+	backofficeuserservice.Service, backofficeuservalidator.Validator,
+	authorizationservice.Service, accesscontrolvalidator.Validator,
+	matchingclient.Client, matchingvalidator.Validator,
+	presenceservice.Service,
+	questionservice.Service, questionvalidator.Validator,
+	gameservice.Service, gamevalidator.Validator,
+	*wshub.Hub, redisnotifier.Notifier, eventhandler.Handler,
 ) {
-	authSvc := authservice.New(cfg.Auth)
+	redisAdapter := redis.New(cfg.Redis)
+
+	eventBus := redisstream.New(cfg.EventBus, redisAdapter)
+
+	authSvc := authservice.New(cfg.Auth, redisauth.New(redisAdapter))
 
 	MysqlRepo := mysql.New(cfg.Mysql)
 
 	userMysql := mysqluser.New(MysqlRepo)
-	userSvc := userservice.New(authSvc, userMysql)
-
-	backofficeUserSvc := backofficeuserservice.New()
 
 	aclMysql := mysqlaccesscontrol.New(MysqlRepo)
-	authorizationSvc := authorizationservice.New(aclMysql)
+	// the instances check the version of the redis cache on each read, so an ACL change is visible to all of them
+	// right after it's made, without it an instance keeps its cached permissions for cache_ttl
+	var aclCache authorizationservice.Cache
+	if cfg.Authorization.RedisCache {
+		aclCache = redisaccesscontrol.New(redisAdapter)
+	}
+	authorizationSvc := authorizationservice.New(cfg.Authorization, aclMysql, userMysql, aclCache)
+	accessControlV := accesscontrolvalidator.New(aclMysql)
+
+	backofficeUserSvc := backofficeuserservice.New(userMysql, authSvc, authorizationSvc)
+	backofficeUserV := backofficeuservalidator.New()
 
 	uV := uservalidator.New(userMysql)
 
 	matchingV := matchingvalidator.New()
 
-	redisAdapter := redis.New(cfg.Redis)
-	matchingRepo := redismatching.New(redisAdapter)
-	matchingSvc := matchingservice.New(cfg.MatchingService, matchingRepo)
+	presenceRepo := redispresence.New(redisAdapter)
+	presenceSvc := presenceservice.New(cfg.PresenceService, presenceRepo)
+
+	gameMysql := mysqlgame.New(MysqlRepo)
+	questionMysql := mysqlquestion.New(MysqlRepo)
+	questionSvc := questionservice.New(questionMysql)
+	questionV := questionvalidator.New()
+
+	ratingMysql := mysqlrating.New(MysqlRepo)
+	ratingSvc := ratingservice.New(cfg.RatingService, ratingMysql)
+
+	// the waiting lists are owned by the matching server, see cmd/matchingserver
+	matchingClient := matchingclient.New(cfg.MatchingClient)
+
+	hub := wshub.New()
+	// the players of a game may be connected to different instances, the events reach all of them
+	gameNotifier := redisnotifier.New(cfg.GameNotifier, redisAdapter, hub)
+	gameSvc := gameservice.New(cfg.GameService, gameMysql, questionMysql, matchingClient, gameNotifier)
+	gameV := gamevalidator.New()
+
+	otpSvc := otpservice.New(cfg.OTPService, redisotp.New(redisAdapter), sms.NewLogSender(cfg.SMS))
+	rateLimitSvc := ratelimitservice.New(cfg.RateLimit, redisratelimit.New(redisAdapter))
+	loginGuardSvc := loginguardservice.New(cfg.LoginGuard, redisloginguard.New(redisAdapter), mysqlaudit.New(MysqlRepo))
+	// register the previous hashers here when the hasher changes, so the stored passwords are still verified
+	passwordHashers := userservice.NewPasswordHashers(userservice.NewBcryptHasher(cfg.UserService.BcryptCost))
+	userSvc := userservice.New(cfg.UserService, authSvc, userMysql, passwordHashers,
+		otpSvc, rateLimitSvc, loginGuardSvc, storage.NewLocalStorage(cfg.Storage), gameSvc)
+
+	// the events are consumed once by one of the instances, the game events are sent to all instances by gameNotifier
+	eventHandler := eventhandler.New(eventBus, gameSvc, ratingSvc)
 
-	return authSvc, userSvc, uV, backofficeUserSvc, authorizationSvc, matchingSvc, matchingV
+	return authSvc, userSvc, uV, backofficeUserSvc, backofficeUserV, authorizationSvc, accessControlV, matchingClient, matchingV, presenceSvc,
+		questionSvc, questionV, gameSvc, gameV, hub, gameNotifier, eventHandler
 }
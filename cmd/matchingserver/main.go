@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"gameAppProject/adapter/presenceclient"
+	"gameAppProject/adapter/redis"
+	"gameAppProject/adapter/redisstream"
+	"gameAppProject/config"
+	"gameAppProject/delivery/grpcserver/matchingserver"
+	"gameAppProject/repository/mysql"
+	"gameAppProject/repository/mysql/mysqlrating"
+	"gameAppProject/repository/redis/redismatching"
+	"gameAppProject/scheduler"
+	"gameAppProject/service/matchingservice"
+	"gameAppProject/service/ratingservice"
+	"gameAppProject/validator/matchingvalidator"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+)
+
+func main() {
+	// TODO - read config path from command line
+	cfg := config.Load("config.yml")
+	fmt.Printf("cfg: %+v\n", cfg)
+
+	redisAdapter := redis.New(cfg.Redis)
+	matchingSvc, matchingV, presenceClient := setupServices(cfg, redisAdapter)
+
+	server := matchingserver.New(cfg, matchingSvc, matchingV)
+	go func() {
+		server.Serve()
+	}()
+
+	done := make(chan bool)
+	var wg sync.WaitGroup
+	go func() {
+		// the outbox is relayed by the scheduler, see cmd/scheduler
+		sch := scheduler.New(cfg.Scheduler, matchingSvc, nil, redisAdapter)
+
+		wg.Add(1)
+		sch.Start(done, &wg)
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt)
+	<-quit
+
+	fmt.Println("received interrupt signal, shutting down gracefully..")
+	server.Shutdown()
+	done <- true
+	time.Sleep(cfg.Application.GracefulShutdownTimeout)
+
+	wg.Wait()
+
+	if err := presenceClient.Close(); err != nil {
+		fmt.Println("presence client close error", err)
+	}
+}
+
+func setupServices(cfg config.Config, redisAdapter redis.Adapter) (
+	matchingservice.Service, matchingvalidator.Validator, presenceclient.Client,
+) {
+	MysqlRepo := mysql.New(cfg.Mysql)
+
+	eventBus := redisstream.New(cfg.EventBus, redisAdapter)
+
+	// the presence of the waiting users is read from the presence grpc server, see cmd/presenceserver
+	presenceClient := presenceclient.New(cfg.PresenceClient)
+
+	matchingRepo := redismatching.New(redisAdapter)
+
+	ratingMysql := mysqlrating.New(MysqlRepo)
+	ratingSvc := ratingservice.New(cfg.RatingService, ratingMysql)
+
+	// the players are connected to the http server instances, so they start the games of the matched users
+	matchingSvc := matchingservice.New(cfg.MatchingService, matchingRepo, presenceClient, eventBus, ratingSvc)
+	matchingV := matchingvalidator.New()
+
+	return matchingSvc, matchingV, presenceClient
+}
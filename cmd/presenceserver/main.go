@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"gameAppProject/adapter/redis"
+	"gameAppProject/config"
+	"gameAppProject/delivery/grpcserver/presenceserver"
+	"gameAppProject/repository/redis/redispresence"
+	"gameAppProject/service/presenceservice"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	// TODO - read config path from command line
+	cfg := config.Load("config.yml")
+	fmt.Printf("cfg: %+v\n", cfg)
+
+	redisAdapter := redis.New(cfg.Redis)
+	presenceRepo := redispresence.New(redisAdapter)
+	presenceSvc := presenceservice.New(cfg.PresenceService, presenceRepo)
+
+	server := presenceserver.New(cfg, presenceSvc)
+	go func() {
+		server.Serve()
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt)
+	<-quit
+
+	fmt.Println("received interrupt signal, shutting down gracefully..")
+	server.Shutdown()
+}
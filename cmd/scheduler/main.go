@@ -2,27 +2,35 @@ package main
 
 import (
 	"fmt"
+	"gameAppProject/adapter/redis"
+	"gameAppProject/adapter/redisstream"
 	"gameAppProject/config"
+	"gameAppProject/repository/mysql"
+	"gameAppProject/repository/mysql/mysqloutbox"
 	"gameAppProject/scheduler"
+	"gameAppProject/service/outboxservice"
 	"os"
 	"os/signal"
+	"sync"
 	"time"
 )
 
-const (
-	JwtSignKey = ""
-)
-
 func main() {
 	// TODO - read config path from command line
 	cfg := config.Load("config.yml")
 	fmt.Printf("cfg: %+v\n", cfg)
 
-	done := make(chan bool)
+	redisAdapter := redis.New(cfg.Redis)
+	outboxSvc := setupServices(cfg, redisAdapter)
 
+	done := make(chan bool)
+	var wg sync.WaitGroup
 	go func() {
-		sch := scheduler.New()
-		sch.Start(done)
+		// the matching rounds are run by the matching server, see cmd/matchingserver
+		sch := scheduler.New(cfg.Scheduler, nil, outboxSvc, redisAdapter)
+
+		wg.Add(1)
+		sch.Start(done, &wg)
 	}()
 
 	quit := make(chan os.Signal, 1)
@@ -32,4 +40,16 @@ func main() {
 	fmt.Println("received interrupt signal, shutting down gracefully..")
 	done <- true
 	time.Sleep(cfg.Application.GracefulShutdownTimeout)
+
+	wg.Wait()
+}
+
+func setupServices(cfg config.Config, redisAdapter redis.Adapter) outboxservice.Service {
+	MysqlRepo := mysql.New(cfg.Mysql)
+
+	eventBus := redisstream.New(cfg.EventBus, redisAdapter)
+
+	outboxSvc := outboxservice.New(cfg.Outbox, mysqloutbox.New(MysqlRepo), eventBus)
+
+	return outboxSvc
 }
@@ -1,12 +1,26 @@
 package config
 
 import (
+	"gameAppProject/adapter/matchingclient"
+	"gameAppProject/adapter/presenceclient"
 	"gameAppProject/adapter/redis"
+	"gameAppProject/adapter/redisnotifier"
+	"gameAppProject/adapter/redisstream"
+	"gameAppProject/adapter/sms"
+	"gameAppProject/adapter/storage"
 	"gameAppProject/repository/mysql"
 	"gameAppProject/scheduler"
+	"gameAppProject/service/authorizationservice"
 	"gameAppProject/service/authservice"
+	"gameAppProject/service/gameservice"
+	"gameAppProject/service/loginguardservice"
 	"gameAppProject/service/matchingservice"
+	"gameAppProject/service/otpservice"
+	"gameAppProject/service/outboxservice"
 	"gameAppProject/service/presenceservice"
+	"gameAppProject/service/ratelimitservice"
+	"gameAppProject/service/ratingservice"
+	"gameAppProject/service/userservice"
 	"time"
 )
 
@@ -16,15 +30,38 @@ type Application struct {
 
 type HTTPServer struct {
 	Port int `koanf:"port"`
+	// TrustedProxies are the CIDRs of the proxies whose X-Forwarded-For is trusted,
+	// the ip of the connection is used when it's empty
+	TrustedProxies []string `koanf:"trusted_proxies"`
+}
+
+type GRPCServer struct {
+	Port int `koanf:"port"`
 }
 
 type Config struct {
-	Application     Application            `koanf:"application"`
-	HTTPServer      HTTPServer             `koanf:"http_server"`
-	Auth            authservice.Config     `koanf:"auth"`
-	Mysql           mysql.Config           `koanf:"mysql"`
-	MatchingService matchingservice.Config `koanf:"matching_service"`
-	Redis           redis.Config           `koanf:"redis"`
-	PresenceService presenceservice.Config `koanf:"presence_service"`
-	Scheduler       scheduler.Config       `koanf:"scheduler"`
+	Application     Application                 `koanf:"application"`
+	HTTPServer      HTTPServer                  `koanf:"http_server"`
+	Auth            authservice.Config          `koanf:"auth"`
+	Mysql           mysql.Config                `koanf:"mysql"`
+	MatchingService matchingservice.Config      `koanf:"matching_service"`
+	Redis           redis.Config                `koanf:"redis"`
+	PresenceService presenceservice.Config      `koanf:"presence_service"`
+	Scheduler       scheduler.Config            `koanf:"scheduler"`
+	GameService     gameservice.Config          `koanf:"game_service"`
+	RatingService   ratingservice.Config        `koanf:"rating_service"`
+	UserService     userservice.Config          `koanf:"user_service"`
+	OTPService      otpservice.Config           `koanf:"otp_service"`
+	RateLimit       ratelimitservice.Config     `koanf:"rate_limit"`
+	LoginGuard      loginguardservice.Config    `koanf:"login_guard"`
+	SMS             sms.Config                  `koanf:"sms"`
+	Storage         storage.Config              `koanf:"storage"`
+	Authorization   authorizationservice.Config `koanf:"authorization_service"`
+	EventBus        redisstream.Config          `koanf:"event_bus"`
+	GameNotifier    redisnotifier.Config        `koanf:"game_notifier"`
+	Outbox          outboxservice.Config        `koanf:"outbox"`
+	PresenceServer  GRPCServer                  `koanf:"presence_server"`
+	PresenceClient  presenceclient.Config       `koanf:"presence_client"`
+	MatchingServer  GRPCServer                  `koanf:"matching_server"`
+	MatchingClient  matchingclient.Config       `koanf:"matching_client"`
 }
@@ -3,9 +3,70 @@ package config
 import "time"
 
 var defaultConfig = map[string]interface{}{
-	"auth.refresh_subject":                  RefreshTokenSubject,
-	"auth.access_subject":                   AccessTokenSubject,
-	"auth.refresh_expiration_time":          RefreshTokenExpireDuration,
-	"auth.access_expiration_time":           AccessTokenExpireDuration,
-	"application.graceful_shutdown_timeout": time.Second * 5,
+	"auth.sign_method":                                 "HS256",
+	"auth.refresh_subject":                             RefreshTokenSubject,
+	"auth.access_subject":                              AccessTokenSubject,
+	"auth.refresh_expiration_time":                     RefreshTokenExpireDuration,
+	"auth.access_expiration_time":                      AccessTokenExpireDuration,
+	"application.graceful_shutdown_timeout":            time.Second * 5,
+	"matching_service.online_threshold":                time.Second * 20,
+	"matching_service.initial_rating_gap":              50,
+	"matching_service.rating_gap_growth_per_second":    5,
+	"matching_service.max_rating_gap":                  400,
+	"rating_service.initial_rating":                    1000,
+	"rating_service.k_factor":                          32,
+	"scheduler.match_waited_users_timeout":             time.Minute * 2,
+	"scheduler.lock_ttl":                               time.Second * 30,
+	"scheduler.relay_outbox_interval_in_seconds":       5,
+	"scheduler.relay_outbox_timeout":                   time.Minute,
+	"outbox.batch_size":                                100,
+	"outbox.retry_delay":                               time.Second * 5,
+	"outbox.max_retry_delay":                           time.Minute * 10,
+	"outbox.max_attempts":                              20,
+	"outbox.retention":                                 time.Hour * 24,
+	"otp_service.code_length":                          6,
+	"otp_service.expiration_time":                      time.Minute * 2,
+	"otp_service.max_attempts":                         5,
+	"otp_service.attempts_window":                      time.Hour,
+	"otp_service.prefix":                               "otp",
+	"rate_limit.prefix":                                "rate_limit",
+	"user_service.otp_login_phone_number_limit":        5,
+	"user_service.otp_login_ip_limit":                  20,
+	"user_service.otp_login_window":                    time.Minute * 10,
+	"user_service.verification_phone_number_limit":     5,
+	"user_service.verification_ip_limit":               20,
+	"user_service.verification_window":                 time.Minute * 10,
+	"user_service.password_reset_phone_number_limit":   5,
+	"user_service.password_reset_ip_limit":             20,
+	"user_service.password_reset_window":               time.Minute * 10,
+	"user_service.password_change_limit":               5,
+	"user_service.password_change_window":              time.Minute * 15,
+	"login_guard.max_failed_attempts_per_phone_number": 5,
+	"login_guard.max_failed_attempts_per_ip":           50,
+	"login_guard.failed_attempts_window":               time.Minute * 15,
+	"login_guard.lockout_duration":                     time.Minute,
+	"login_guard.max_lockout_duration":                 time.Hour,
+	"login_guard.prefix":                               "login_guard",
+	"user_service.avatar_max_size":                     2 << 20,
+	"user_service.avatar_max_dimension":                4096,
+	"user_service.avatar_thumbnail_size":               128,
+	"storage.base_path":                                "./storage",
+	"storage.base_url":                                 "/static",
+	"authorization_service.cache_size":                 10000,
+	"authorization_service.cache_ttl":                  time.Second * 30,
+	"authorization_service.redis_cache":                false,
+	"authorization_service.redis_cache_ttl":            time.Minute * 10,
+	"event_bus.prefix":                                 "events",
+	"event_bus.max_len":                                100000,
+	"event_bus.batch_size":                             10,
+	"event_bus.block_timeout":                          time.Second * 2,
+	"event_bus.reclaim_idle":                           time.Minute,
+	"event_bus.reclaim_interval":                       time.Second * 30,
+	"event_bus.max_deliveries":                         5,
+	"game_notifier.channel":                            "game_events",
+	"authorization_service.prefix":                     "access_control",
+	"presence_server.port":                             8086,
+	"presence_client.address":                          "localhost:8086",
+	"matching_server.port":                             8087,
+	"matching_client.address":                          "localhost:8087",
 }
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: matching/matching.proto
+
+package matching
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MatchingService_AddToWaitingList_FullMethodName    = "/matching.MatchingService/AddToWaitingList"
+	MatchingService_LeaveWaitingList_FullMethodName    = "/matching.MatchingService/LeaveWaitingList"
+	MatchingService_GetWaitingStatus_FullMethodName    = "/matching.MatchingService/GetWaitingStatus"
+	MatchingService_ClaimMatchedUsers_FullMethodName   = "/matching.MatchingService/ClaimMatchedUsers"
+	MatchingService_RestoreMatchedUsers_FullMethodName = "/matching.MatchingService/RestoreMatchedUsers"
+)
+
+// MatchingServiceClient is the client API for MatchingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MatchingServiceClient interface {
+	AddToWaitingList(ctx context.Context, in *AddToWaitingListRequest, opts ...grpc.CallOption) (*AddToWaitingListResponse, error)
+	LeaveWaitingList(ctx context.Context, in *LeaveWaitingListRequest, opts ...grpc.CallOption) (*LeaveWaitingListResponse, error)
+	GetWaitingStatus(ctx context.Context, in *GetWaitingStatusRequest, opts ...grpc.CallOption) (*GetWaitingStatusResponse, error)
+	ClaimMatchedUsers(ctx context.Context, in *ClaimMatchedUsersRequest, opts ...grpc.CallOption) (*ClaimMatchedUsersResponse, error)
+	RestoreMatchedUsers(ctx context.Context, in *RestoreMatchedUsersRequest, opts ...grpc.CallOption) (*RestoreMatchedUsersResponse, error)
+}
+
+type matchingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMatchingServiceClient(cc grpc.ClientConnInterface) MatchingServiceClient {
+	return &matchingServiceClient{cc}
+}
+
+func (c *matchingServiceClient) AddToWaitingList(ctx context.Context, in *AddToWaitingListRequest, opts ...grpc.CallOption) (*AddToWaitingListResponse, error) {
+	out := new(AddToWaitingListResponse)
+	err := c.cc.Invoke(ctx, MatchingService_AddToWaitingList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) LeaveWaitingList(ctx context.Context, in *LeaveWaitingListRequest, opts ...grpc.CallOption) (*LeaveWaitingListResponse, error) {
+	out := new(LeaveWaitingListResponse)
+	err := c.cc.Invoke(ctx, MatchingService_LeaveWaitingList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetWaitingStatus(ctx context.Context, in *GetWaitingStatusRequest, opts ...grpc.CallOption) (*GetWaitingStatusResponse, error) {
+	out := new(GetWaitingStatusResponse)
+	err := c.cc.Invoke(ctx, MatchingService_GetWaitingStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ClaimMatchedUsers(ctx context.Context, in *ClaimMatchedUsersRequest, opts ...grpc.CallOption) (*ClaimMatchedUsersResponse, error) {
+	out := new(ClaimMatchedUsersResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ClaimMatchedUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) RestoreMatchedUsers(ctx context.Context, in *RestoreMatchedUsersRequest, opts ...grpc.CallOption) (*RestoreMatchedUsersResponse, error) {
+	out := new(RestoreMatchedUsersResponse)
+	err := c.cc.Invoke(ctx, MatchingService_RestoreMatchedUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MatchingServiceServer is the server API for MatchingService service.
+// All implementations must embed UnimplementedMatchingServiceServer
+// for forward compatibility
+type MatchingServiceServer interface {
+	AddToWaitingList(context.Context, *AddToWaitingListRequest) (*AddToWaitingListResponse, error)
+	LeaveWaitingList(context.Context, *LeaveWaitingListRequest) (*LeaveWaitingListResponse, error)
+	GetWaitingStatus(context.Context, *GetWaitingStatusRequest) (*GetWaitingStatusResponse, error)
+	ClaimMatchedUsers(context.Context, *ClaimMatchedUsersRequest) (*ClaimMatchedUsersResponse, error)
+	RestoreMatchedUsers(context.Context, *RestoreMatchedUsersRequest) (*RestoreMatchedUsersResponse, error)
+	mustEmbedUnimplementedMatchingServiceServer()
+}
+
+// UnimplementedMatchingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMatchingServiceServer struct {
+}
+
+func (UnimplementedMatchingServiceServer) AddToWaitingList(context.Context, *AddToWaitingListRequest) (*AddToWaitingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWaitingList not implemented")
+}
+func (UnimplementedMatchingServiceServer) LeaveWaitingList(context.Context, *LeaveWaitingListRequest) (*LeaveWaitingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitingList not implemented")
+}
+func (UnimplementedMatchingServiceServer) GetWaitingStatus(context.Context, *GetWaitingStatusRequest) (*GetWaitingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitingStatus not implemented")
+}
+func (UnimplementedMatchingServiceServer) ClaimMatchedUsers(context.Context, *ClaimMatchedUsersRequest) (*ClaimMatchedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimMatchedUsers not implemented")
+}
+func (UnimplementedMatchingServiceServer) RestoreMatchedUsers(context.Context, *RestoreMatchedUsersRequest) (*RestoreMatchedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMatchedUsers not implemented")
+}
+func (UnimplementedMatchingServiceServer) mustEmbedUnimplementedMatchingServiceServer() {}
+
+// UnsafeMatchingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MatchingServiceServer will
+// result in compilation errors.
+type UnsafeMatchingServiceServer interface {
+	mustEmbedUnimplementedMatchingServiceServer()
+}
+
+func RegisterMatchingServiceServer(s grpc.ServiceRegistrar, srv MatchingServiceServer) {
+	s.RegisterService(&MatchingService_ServiceDesc, srv)
+}
+
+func _MatchingService_AddToWaitingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWaitingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).AddToWaitingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_AddToWaitingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).AddToWaitingList(ctx, req.(*AddToWaitingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_LeaveWaitingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).LeaveWaitingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_LeaveWaitingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).LeaveWaitingList(ctx, req.(*LeaveWaitingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetWaitingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetWaitingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_GetWaitingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetWaitingStatus(ctx, req.(*GetWaitingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ClaimMatchedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimMatchedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ClaimMatchedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ClaimMatchedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ClaimMatchedUsers(ctx, req.(*ClaimMatchedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_RestoreMatchedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMatchedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).RestoreMatchedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_RestoreMatchedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).RestoreMatchedUsers(ctx, req.(*RestoreMatchedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MatchingService_ServiceDesc is the grpc.ServiceDesc for MatchingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MatchingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "matching.MatchingService",
+	HandlerType: (*MatchingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddToWaitingList",
+			Handler:    _MatchingService_AddToWaitingList_Handler,
+		},
+		{
+			MethodName: "LeaveWaitingList",
+			Handler:    _MatchingService_LeaveWaitingList_Handler,
+		},
+		{
+			MethodName: "GetWaitingStatus",
+			Handler:    _MatchingService_GetWaitingStatus_Handler,
+		},
+		{
+			MethodName: "ClaimMatchedUsers",
+			Handler:    _MatchingService_ClaimMatchedUsers_Handler,
+		},
+		{
+			MethodName: "RestoreMatchedUsers",
+			Handler:    _MatchingService_RestoreMatchedUsers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "matching/matching.proto",
+}
@@ -0,0 +1,39 @@
+package eventhandler
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/event"
+)
+
+// gameFinished updates the ratings of the players, an error is retried by redelivering the event.
+func (h Handler) gameFinished(ctx context.Context, e event.Event) error {
+	var payload entity.GameFinished
+	if err := e.Decode(&payload); err != nil {
+		// TODO - log error, a malformed event is never processed
+		fmt.Println("gameFinished decode error", e.ID, err)
+
+		return nil
+	}
+
+	scores := make([]param.PlayerScore, 0, len(payload.Scores))
+	for _, s := range payload.Scores {
+		scores = append(scores, param.PlayerScore{UserID: s.UserID, Score: s.Score})
+	}
+
+	if _, err := h.ratingSvc.UpdateRatings(ctx, param.UpdateRatingsRequest{
+		GameID:   payload.GameID,
+		Category: payload.Category,
+		Scores:   scores,
+	}); err != nil {
+		// TODO - log error
+		// TODO - update metrics
+		fmt.Println("ratingSvc.UpdateRatings error", e.ID, err)
+
+		return err
+	}
+
+	return nil
+}
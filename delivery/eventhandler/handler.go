@@ -0,0 +1,51 @@
+package eventhandler
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/event"
+	"gameAppProject/service/gameservice"
+	"gameAppProject/service/ratingservice"
+	"sync"
+)
+
+const (
+	gameServiceGroup   = "game_service"
+	ratingServiceGroup = "rating_service"
+)
+
+type Handler struct {
+	consumer  event.Consumer
+	gameSvc   gameservice.Service
+	ratingSvc ratingservice.Service
+}
+
+func New(consumer event.Consumer, gameSvc gameservice.Service, ratingSvc ratingservice.Service) Handler {
+	return Handler{consumer: consumer, gameSvc: gameSvc, ratingSvc: ratingSvc}
+}
+
+// Start consumes the events until ctx is done.
+func (h Handler) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := h.consumer.Subscribe(ctx, gameServiceGroup,
+			[]entity.EventType{entity.UsersMatchedEvent}, h.usersMatched); err != nil {
+			// TODO - log error
+			fmt.Println("consumer.Subscribe error", gameServiceGroup, err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := h.consumer.Subscribe(ctx, ratingServiceGroup,
+			[]entity.EventType{entity.GameFinishedEvent}, h.gameFinished); err != nil {
+			// TODO - log error
+			fmt.Println("consumer.Subscribe error", ratingServiceGroup, err)
+		}
+	}()
+}
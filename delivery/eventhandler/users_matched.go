@@ -0,0 +1,38 @@
+package eventhandler
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/event"
+	"gameAppProject/pkg/richerror"
+)
+
+func (h Handler) usersMatched(ctx context.Context, e event.Event) error {
+	var payload entity.UsersMatched
+	if err := e.Decode(&payload); err != nil {
+		// TODO - log error, a malformed event is never processed
+		fmt.Println("usersMatched decode error", e.ID, err)
+
+		return nil
+	}
+
+	_, err := h.gameSvc.StartGame(ctx, param.StartGameRequest{
+		Category: payload.Category,
+		UserIDs:  payload.UserIDs,
+	})
+	if err != nil {
+		// e.g. another round has already matched one of the users, retrying doesn't help
+		if re, ok := err.(richerror.RichError); ok && re.Kind() != richerror.KindUnexpected {
+			// TODO - log error
+			fmt.Println("gameSvc.StartGame error", e.ID, err)
+
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package matchingserver
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/config"
+	"gameAppProject/contract/goproto/matching"
+	"gameAppProject/pkg/grpcmsg"
+	"gameAppProject/pkg/protobufmapper"
+	"gameAppProject/service/matchingservice"
+	"gameAppProject/validator/matchingvalidator"
+	"google.golang.org/grpc"
+	"net"
+)
+
+type Server struct {
+	matching.UnimplementedMatchingServiceServer
+	config            config.Config
+	matchingSvc       matchingservice.Service
+	matchingValidator matchingvalidator.Validator
+	grpcServer        *grpc.Server
+}
+
+func New(config config.Config, matchingSvc matchingservice.Service,
+	matchingValidator matchingvalidator.Validator) Server {
+	return Server{
+		config:            config,
+		matchingSvc:       matchingSvc,
+		matchingValidator: matchingValidator,
+		grpcServer:        grpc.NewServer(),
+	}
+}
+
+func (s Server) AddToWaitingList(ctx context.Context, req *matching.AddToWaitingListRequest) (
+	*matching.AddToWaitingListResponse, error) {
+	addReq := protobufmapper.MapAddToWaitingListRequestFromProtobuf(req)
+
+	// the request may come from any client, not only the http server
+	if _, err := s.matchingValidator.ValidateAddToWaitingListRequest(addReq); err != nil {
+		return nil, grpcmsg.Error(err)
+	}
+
+	res, err := s.matchingSvc.AddToWaitingList(ctx, addReq)
+	if err != nil {
+		return nil, grpcmsg.Error(err)
+	}
+
+	return protobufmapper.MapAddToWaitingListResponseToProtobuf(res), nil
+}
+
+func (s Server) LeaveWaitingList(ctx context.Context, req *matching.LeaveWaitingListRequest) (
+	*matching.LeaveWaitingListResponse, error) {
+	_, err := s.matchingSvc.LeaveWaitingList(ctx, protobufmapper.MapLeaveWaitingListRequestFromProtobuf(req))
+	if err != nil {
+		return nil, grpcmsg.Error(err)
+	}
+
+	return &matching.LeaveWaitingListResponse{}, nil
+}
+
+func (s Server) GetWaitingStatus(ctx context.Context, req *matching.GetWaitingStatusRequest) (
+	*matching.GetWaitingStatusResponse, error) {
+	res, err := s.matchingSvc.GetWaitingStatus(ctx, protobufmapper.MapGetWaitingStatusRequestFromProtobuf(req))
+	if err != nil {
+		return nil, grpcmsg.Error(err)
+	}
+
+	return protobufmapper.MapGetWaitingStatusResponseToProtobuf(res), nil
+}
+
+func (s Server) ClaimMatchedUsers(ctx context.Context, req *matching.ClaimMatchedUsersRequest) (
+	*matching.ClaimMatchedUsersResponse, error) {
+	res, err := s.matchingSvc.ClaimMatchedUsers(ctx, protobufmapper.MapClaimMatchedUsersRequestFromProtobuf(req))
+	if err != nil {
+		return nil, grpcmsg.Error(err)
+	}
+
+	return protobufmapper.MapClaimMatchedUsersResponseToProtobuf(res), nil
+}
+
+func (s Server) RestoreMatchedUsers(ctx context.Context, req *matching.RestoreMatchedUsersRequest) (
+	*matching.RestoreMatchedUsersResponse, error) {
+	_, err := s.matchingSvc.RestoreMatchedUsers(ctx, protobufmapper.MapRestoreMatchedUsersRequestFromProtobuf(req))
+	if err != nil {
+		return nil, grpcmsg.Error(err)
+	}
+
+	return &matching.RestoreMatchedUsersResponse{}, nil
+}
+
+func (s Server) Serve() {
+	address := fmt.Sprintf(":%d", s.config.MatchingServer.Port)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		panic(fmt.Errorf("can't listen on %s: %v", address, err))
+	}
+
+	matching.RegisterMatchingServiceServer(s.grpcServer, s)
+
+	fmt.Printf("start matching grpc server on %s\n", address)
+	if err := s.grpcServer.Serve(listener); err != nil {
+		fmt.Println("matching grpc server serve error", err)
+	}
+}
+
+// Shutdown stops accepting new calls and waits for the in-flight calls to finish.
+func (s Server) Shutdown() {
+	s.grpcServer.GracefulStop()
+}
@@ -0,0 +1,62 @@
+package presenceserver
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/config"
+	"gameAppProject/contract/goproto/presence"
+	"gameAppProject/pkg/grpcmsg"
+	"gameAppProject/pkg/protobufmapper"
+	"gameAppProject/service/presenceservice"
+	"google.golang.org/grpc"
+	"net"
+)
+
+type Server struct {
+	presence.UnimplementedPresenceServiceServer
+	config      config.Config
+	presenceSvc presenceservice.Service
+	grpcServer  *grpc.Server
+}
+
+func New(config config.Config, presenceSvc presenceservice.Service) Server {
+	return Server{config: config, presenceSvc: presenceSvc, grpcServer: grpc.NewServer()}
+}
+
+func (s Server) Upsert(ctx context.Context, req *presence.UpsertPresenceRequest) (*presence.UpsertPresenceResponse, error) {
+	_, err := s.presenceSvc.Upsert(ctx, protobufmapper.MapUpsertPresenceRequestFromProtobuf(req))
+	if err != nil {
+		return nil, grpcmsg.Error(err)
+	}
+
+	return &presence.UpsertPresenceResponse{}, nil
+}
+
+func (s Server) GetPresence(ctx context.Context, req *presence.GetPresenceRequest) (*presence.GetPresenceResponse, error) {
+	res, err := s.presenceSvc.GetPresence(ctx, protobufmapper.MapGetPresenceRequestFromProtobuf(req))
+	if err != nil {
+		return nil, grpcmsg.Error(err)
+	}
+
+	return protobufmapper.MapGetPresenceResponseToProtobuf(res), nil
+}
+
+func (s Server) Serve() {
+	address := fmt.Sprintf(":%d", s.config.PresenceServer.Port)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		panic(fmt.Errorf("can't listen on %s: %v", address, err))
+	}
+
+	presence.RegisterPresenceServiceServer(s.grpcServer, s)
+
+	fmt.Printf("start presence grpc server on %s\n", address)
+	if err := s.grpcServer.Serve(listener); err != nil {
+		fmt.Println("presence grpc server serve error", err)
+	}
+}
+
+// Shutdown stops accepting new calls and waits for the in-flight calls to finish.
+func (s Server) Shutdown() {
+	s.grpcServer.GracefulStop()
+}
@@ -0,0 +1,15 @@
+package authhandler
+
+import (
+	"gameAppProject/service/authservice"
+)
+
+type Handler struct {
+	authSvc authservice.Service
+}
+
+func New(authSvc authservice.Service) Handler {
+	return Handler{
+		authSvc: authSvc,
+	}
+}
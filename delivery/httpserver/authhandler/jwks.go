@@ -0,0 +1,18 @@
+package authhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) jwks(c echo.Context) error {
+	resp, err := h.authSvc.JWKS(param.JWKSRequest{})
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,9 @@
+package authhandler
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handler) SetRoutes(e *echo.Echo) {
+	e.GET("/.well-known/jwks.json", h.jwks)
+}
@@ -0,0 +1,74 @@
+package backofficeaccesscontrolhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/claim"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) listAccessControls(c echo.Context) error {
+	var req param.AccessControlListRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	if fieldErrors, err := h.accessControlValidator.ValidateListRequest(req); err != nil {
+		msg, code := httpmsg.Error(err)
+		return c.JSON(code, echo.Map{
+			"message": msg,
+			"errors":  fieldErrors,
+		})
+	}
+
+	resp, err := h.authorizationSvc.ListAccessControls(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h Handler) grantAccess(c echo.Context) error {
+	var req param.AccessControlGrantRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	if fieldErrors, err := h.accessControlValidator.ValidateGrantRequest(req); err != nil {
+		msg, code := httpmsg.Error(err)
+		return c.JSON(code, echo.Map{
+			"message": msg,
+			"errors":  fieldErrors,
+		})
+	}
+
+	resp, err := h.authorizationSvc.GrantAccess(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func (h Handler) revokeAccess(c echo.Context) error {
+	var req param.AccessControlRevokeRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	claims := claim.GetClaimsFromEchoContext(c)
+	req.AdminID = claims.UserID
+	req.AdminRole = claims.Role
+
+	resp, err := h.authorizationSvc.RevokeAccess(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,24 @@
+package backofficeaccesscontrolhandler
+
+import (
+	"gameAppProject/service/authorizationservice"
+	"gameAppProject/service/authservice"
+	"gameAppProject/validator/accesscontrolvalidator"
+)
+
+type Handler struct {
+	authConfig             authservice.Config
+	authSvc                authservice.Service
+	authorizationSvc       authorizationservice.Service
+	accessControlValidator accesscontrolvalidator.Validator
+}
+
+func New(authConfig authservice.Config, authSvc authservice.Service,
+	authorizationSvc authorizationservice.Service, accessControlValidator accesscontrolvalidator.Validator) Handler {
+	return Handler{
+		authConfig:             authConfig,
+		authSvc:                authSvc,
+		authorizationSvc:       authorizationSvc,
+		accessControlValidator: accessControlValidator,
+	}
+}
@@ -0,0 +1,56 @@
+package backofficeaccesscontrolhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) listPermissions(c echo.Context) error {
+	resp, err := h.authorizationSvc.ListPermissions(c.Request().Context(), param.PermissionListRequest{})
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h Handler) createPermission(c echo.Context) error {
+	var req param.PermissionCreateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	if fieldErrors, err := h.accessControlValidator.ValidateCreatePermissionRequest(req); err != nil {
+		msg, code := httpmsg.Error(err)
+		return c.JSON(code, echo.Map{
+			"message": msg,
+			"errors":  fieldErrors,
+		})
+	}
+
+	resp, err := h.authorizationSvc.CreatePermission(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func (h Handler) userPermissions(c echo.Context) error {
+	var req param.UserPermissionsRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	resp, err := h.authorizationSvc.GetUserPermissions(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,24 @@
+package backofficeaccesscontrolhandler
+
+import (
+	"gameAppProject/delivery/httpserver/middleware"
+	"gameAppProject/entity"
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handler) SetRoutes(e *echo.Echo) {
+	backofficeGroup := e.Group("/backoffice")
+
+	backofficeGroup.GET("/permissions", h.listPermissions, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.AccessControlManagePermission))
+	backofficeGroup.POST("/permissions", h.createPermission, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.AccessControlManagePermission))
+	backofficeGroup.GET("/access-controls", h.listAccessControls, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.AccessControlManagePermission))
+	backofficeGroup.POST("/access-controls", h.grantAccess, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.AccessControlManagePermission))
+	backofficeGroup.DELETE("/access-controls/:id", h.revokeAccess, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.AccessControlManagePermission))
+	backofficeGroup.GET("/users/:id/permissions", h.userPermissions, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.AccessControlManagePermission))
+}
@@ -0,0 +1,31 @@
+package backofficequestionhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) createQuestion(c echo.Context) error {
+	var req param.QuestionCreateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	if fieldErrors, err := h.questionValidator.ValidateCreateQuestionRequest(req); err != nil {
+		msg, code := httpmsg.Error(err)
+		return c.JSON(code, echo.Map{
+			"message": msg,
+			"errors":  fieldErrors,
+		})
+	}
+
+	resp, err := h.questionSvc.Create(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
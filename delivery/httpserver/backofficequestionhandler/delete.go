@@ -0,0 +1,23 @@
+package backofficequestionhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) deleteQuestion(c echo.Context) error {
+	var req param.QuestionDeleteRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	resp, err := h.questionSvc.Delete(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
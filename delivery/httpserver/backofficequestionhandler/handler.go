@@ -0,0 +1,28 @@
+package backofficequestionhandler
+
+import (
+	"gameAppProject/service/authorizationservice"
+	"gameAppProject/service/authservice"
+	"gameAppProject/service/questionservice"
+	"gameAppProject/validator/questionvalidator"
+)
+
+type Handler struct {
+	authConfig        authservice.Config
+	authSvc           authservice.Service
+	authorizationSvc  authorizationservice.Service
+	questionSvc       questionservice.Service
+	questionValidator questionvalidator.Validator
+}
+
+func New(authConfig authservice.Config, authSvc authservice.Service,
+	authorizationSvc authorizationservice.Service, questionSvc questionservice.Service,
+	questionValidator questionvalidator.Validator) Handler {
+	return Handler{
+		authConfig:        authConfig,
+		authSvc:           authSvc,
+		authorizationSvc:  authorizationSvc,
+		questionSvc:       questionSvc,
+		questionValidator: questionValidator,
+	}
+}
@@ -0,0 +1,31 @@
+package backofficequestionhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) listQuestions(c echo.Context) error {
+	var req param.QuestionListRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	if fieldErrors, err := h.questionValidator.ValidateListQuestionRequest(req); err != nil {
+		msg, code := httpmsg.Error(err)
+		return c.JSON(code, echo.Map{
+			"message": msg,
+			"errors":  fieldErrors,
+		})
+	}
+
+	resp, err := h.questionSvc.List(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
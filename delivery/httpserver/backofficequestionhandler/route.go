@@ -0,0 +1,20 @@
+package backofficequestionhandler
+
+import (
+	"gameAppProject/delivery/httpserver/middleware"
+	"gameAppProject/entity"
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handler) SetRoutes(e *echo.Echo) {
+	questionGroup := e.Group("/backoffice/questions")
+
+	questionGroup.GET("/", h.listQuestions, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.QuestionListPermission))
+	questionGroup.POST("/", h.createQuestion, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.QuestionCreatePermission))
+	questionGroup.PUT("/:id", h.updateQuestion, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.QuestionUpdatePermission))
+	questionGroup.DELETE("/:id", h.deleteQuestion, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.QuestionDeletePermission))
+}
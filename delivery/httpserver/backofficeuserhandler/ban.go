@@ -0,0 +1,47 @@
+package backofficeuserhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/claim"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) banUser(c echo.Context) error {
+	var req param.BanUserRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	claims := claim.GetClaimsFromEchoContext(c)
+	req.ActorID = claims.UserID
+	req.ActorRole = claims.Role
+
+	resp, err := h.backofficeUserSvc.BanUser(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h Handler) unbanUser(c echo.Context) error {
+	var req param.UnbanUserRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	claims := claim.GetClaimsFromEchoContext(c)
+	req.ActorID = claims.UserID
+	req.ActorRole = claims.Role
+
+	resp, err := h.backofficeUserSvc.UnbanUser(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
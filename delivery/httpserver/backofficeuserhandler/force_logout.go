@@ -0,0 +1,28 @@
+package backofficeuserhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/claim"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) forceLogout(c echo.Context) error {
+	var req param.ForceLogoutRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	claims := claim.GetClaimsFromEchoContext(c)
+	req.ActorID = claims.UserID
+	req.ActorRole = claims.Role
+
+	resp, err := h.backofficeUserSvc.ForceLogout(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -4,21 +4,25 @@ import (
 	"gameAppProject/service/authorizationservice"
 	"gameAppProject/service/authservice"
 	"gameAppProject/service/backofficeuserservice"
+	"gameAppProject/validator/backofficeuservalidator"
 )
 
 type Handler struct {
-	authConfig        authservice.Config
-	authSvc           authservice.Service
-	authorizationSvc  authorizationservice.Service
-	backofficeUserSvc backofficeuserservice.Service
+	authConfig              authservice.Config
+	authSvc                 authservice.Service
+	authorizationSvc        authorizationservice.Service
+	backofficeUserSvc       backofficeuserservice.Service
+	backofficeUserValidator backofficeuservalidator.Validator
 }
 
 func New(authConfig authservice.Config, authSvc authservice.Service,
-	backofficeUserSvc backofficeuserservice.Service, authorizationSvc authorizationservice.Service) Handler {
+	backofficeUserSvc backofficeuserservice.Service, authorizationSvc authorizationservice.Service,
+	backofficeUserValidator backofficeuservalidator.Validator) Handler {
 	return Handler{
-		authConfig:        authConfig,
-		authSvc:           authSvc,
-		backofficeUserSvc: backofficeUserSvc,
-		authorizationSvc:  authorizationSvc,
+		authConfig:              authConfig,
+		authSvc:                 authSvc,
+		backofficeUserSvc:       backofficeUserSvc,
+		authorizationSvc:        authorizationSvc,
+		backofficeUserValidator: backofficeUserValidator,
 	}
 }
@@ -1,19 +1,46 @@
 package backofficeuserhandler
 
 import (
+	"gameAppProject/param"
 	"gameAppProject/pkg/httpmsg"
 	"github.com/labstack/echo/v4"
 	"net/http"
 )
 
 func (h Handler) listUsers(c echo.Context) error {
-	list, err := h.backofficeUserSvc.ListAllUsers()
+	var req param.BackofficeUserListRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	if fieldErrors, err := h.backofficeUserValidator.ValidateListUsersRequest(req); err != nil {
+		msg, code := httpmsg.Error(err)
+		return c.JSON(code, echo.Map{
+			"message": msg,
+			"errors":  fieldErrors,
+		})
+	}
+
+	resp, err := h.backofficeUserSvc.ListUsers(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h Handler) getUser(c echo.Context) error {
+	var req param.BackofficeUserDetailRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	resp, err := h.backofficeUserSvc.GetUser(c.Request().Context(), req)
 	if err != nil {
 		msg, code := httpmsg.Error(err)
 		return echo.NewHTTPError(code, msg)
 	}
 
-	return c.JSON(http.StatusOK, echo.Map{
-		"data": list,
-	})
+	return c.JSON(http.StatusOK, resp)
 }
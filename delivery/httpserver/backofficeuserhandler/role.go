@@ -0,0 +1,36 @@
+package backofficeuserhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/claim"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) changeRole(c echo.Context) error {
+	var req param.ChangeRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	claims := claim.GetClaimsFromEchoContext(c)
+	req.ActorID = claims.UserID
+	req.ActorRole = claims.Role
+
+	if fieldErrors, err := h.backofficeUserValidator.ValidateChangeRoleRequest(req); err != nil {
+		msg, code := httpmsg.Error(err)
+		return c.JSON(code, echo.Map{
+			"message": msg,
+			"errors":  fieldErrors,
+		})
+	}
+
+	resp, err := h.backofficeUserSvc.ChangeRole(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -11,4 +11,16 @@ func (h Handler) SetRoutes(e *echo.Echo) {
 
 	userGroup.GET("/", h.listUsers, middleware.Auth(h.authSvc, h.authConfig),
 		middleware.AccessCheck(h.authorizationSvc, entity.UserListPermission))
+	userGroup.GET("/:id", h.getUser, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.UserListPermission))
+	userGroup.DELETE("/:id", h.deleteUser, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.UserDeletePermission))
+	userGroup.POST("/:id/ban", h.banUser, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.UserBanPermission))
+	userGroup.POST("/:id/unban", h.unbanUser, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.UserBanPermission))
+	userGroup.PUT("/:id/role", h.changeRole, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.UserRoleUpdatePermission))
+	userGroup.POST("/:id/logout", h.forceLogout, middleware.Auth(h.authSvc, h.authConfig),
+		middleware.AccessCheck(h.authorizationSvc, entity.UserForceLogoutPermission))
 }
@@ -0,0 +1,33 @@
+package gamehandler
+
+import (
+	"gameAppProject/delivery/wshub"
+	"gameAppProject/service/authservice"
+	"gameAppProject/service/gameservice"
+	"gameAppProject/service/presenceservice"
+	"gameAppProject/validator/gamevalidator"
+)
+
+type Handler struct {
+	authConfig    authservice.Config
+	authSvc       authservice.Service
+	gameSvc       gameservice.Service
+	gameValidator gamevalidator.Validator
+	presenceSvc   presenceservice.Service
+	hub           *wshub.Hub
+}
+
+func New(authConfig authservice.Config, authSvc authservice.Service,
+	gameSvc gameservice.Service,
+	gameValidator gamevalidator.Validator,
+	presenceSvc presenceservice.Service,
+	hub *wshub.Hub) Handler {
+	return Handler{
+		authConfig:    authConfig,
+		authSvc:       authSvc,
+		gameSvc:       gameSvc,
+		gameValidator: gameValidator,
+		presenceSvc:   presenceSvc,
+		hub:           hub,
+	}
+}
@@ -0,0 +1,123 @@
+package gamehandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gameAppProject/delivery/wshub"
+	"gameAppProject/param"
+	"gameAppProject/pkg/claim"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/httpmsg"
+	"gameAppProject/pkg/timestamp"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"time"
+)
+
+const (
+	messageHandleTimeout  = 10 * time.Second
+	presenceUpsertTimeout = 2 * time.Second
+)
+
+// the default CheckOrigin rejects cross-origin browsers and accepts clients without an Origin header
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+func (h Handler) play(c echo.Context) error {
+	claims := claim.GetClaimsFromEchoContext(c)
+
+	conn, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// upgrader has already written the http error
+		return nil
+	}
+
+	client := wshub.NewClient(claims.UserID, conn)
+	h.hub.Register(client)
+	defer h.hub.Unregister(client)
+
+	go client.WritePump()
+
+	client.ReadPump(func(message []byte) {
+		h.handleGameMessage(client, message)
+	}, func() {
+		h.upsertPresence(client)
+	})
+
+	return nil
+}
+
+func (h Handler) handleGameMessage(client *wshub.Client, message []byte) {
+	// every message shows that the user is still online
+	h.upsertPresence(client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), messageHandleTimeout)
+	defer cancel()
+
+	var msg param.GameMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		client.Send(errorEvent(errmsg.ErrorMsgInvalidInput, nil))
+
+		return
+	}
+
+	switch msg.Type {
+	case param.GameMessageAnswer:
+		h.handleAnswerMessage(ctx, client, msg.Payload)
+	default:
+		client.Send(errorEvent(errmsg.ErrorMsgInvalidInput, nil))
+	}
+}
+
+func (h Handler) handleAnswerMessage(ctx context.Context, client *wshub.Client, payload json.RawMessage) {
+	var req param.AnswerQuestionRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		client.Send(errorEvent(errmsg.ErrorMsgInvalidInput, nil))
+
+		return
+	}
+
+	req.UserID = client.UserID()
+
+	if fieldErrors, err := h.gameValidator.ValidateAnswerQuestionRequest(req); err != nil {
+		msg, _ := httpmsg.Error(err)
+		client.Send(errorEvent(msg, fieldErrors))
+
+		return
+	}
+
+	resp, err := h.gameSvc.AnswerQuestion(ctx, req)
+	if err != nil {
+		msg, _ := httpmsg.Error(err)
+		client.Send(errorEvent(msg, nil))
+
+		return
+	}
+
+	client.Send(param.GameEvent{Type: param.GameEventAnswerResult, Payload: resp})
+}
+
+// upsertPresence keeps the user online while it's connected, even if it doesn't send any message,
+// otherwise an idle player is removed from the waiting list as offline.
+func (h Handler) upsertPresence(client *wshub.Client) {
+	ctx, cancel := context.WithTimeout(context.Background(), presenceUpsertTimeout)
+	defer cancel()
+
+	if _, err := h.presenceSvc.Upsert(ctx, param.UpsertPresenceRequest{
+		UserID:    client.UserID(),
+		Timestamp: timestamp.Now(),
+	}); err != nil {
+		// TODO - log unexpected error
+		fmt.Println("presenceSvc.Upsert error", err)
+	}
+}
+
+func errorEvent(message string, fieldErrors map[string]string) param.GameEvent {
+	return param.GameEvent{
+		Type:    param.GameEventError,
+		Payload: param.ErrorEventPayload{Message: message, Errors: fieldErrors},
+	}
+}
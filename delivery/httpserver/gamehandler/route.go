@@ -0,0 +1,15 @@
+package gamehandler
+
+import (
+	"gameAppProject/delivery/httpserver/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handler) SetRoutes(e *echo.Echo) {
+	gameGroup := e.Group("/games")
+
+	gameGroup.POST("/answer-question", h.answerQuestion,
+		middleware.Auth(h.authSvc, h.authConfig), middleware.UpsertPresence(h.presenceSvc))
+	gameGroup.GET("/ws", h.play,
+		middleware.WebSocketAuth(h.authSvc, h.authConfig), middleware.UpsertPresence(h.presenceSvc))
+}
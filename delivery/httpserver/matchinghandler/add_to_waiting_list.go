@@ -25,7 +25,7 @@ func (h Handler) addToWaitingList(c echo.Context) error {
 		})
 	}
 
-	resp, err := h.matchingSvc.AddToWaitingList(req)
+	resp, err := h.matchingClient.AddToWaitingList(c.Request().Context(), req)
 	if err != nil {
 		msg, code := httpmsg.Error(err)
 		return echo.NewHTTPError(code, msg)
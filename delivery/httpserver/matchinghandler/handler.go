@@ -1,28 +1,36 @@
 package matchinghandler
 
 import (
+	"context"
+	"gameAppProject/param"
 	"gameAppProject/service/authservice"
-	"gameAppProject/service/matchingservice"
 	"gameAppProject/service/presenceservice"
 	"gameAppProject/validator/matchingvalidator"
 )
 
+// MatchingClient calls the matching service, it runs in its own binary, see cmd/matchingserver.
+type MatchingClient interface {
+	AddToWaitingList(ctx context.Context, req param.AddToWaitingListRequest) (param.AddToWaitingListResponse, error)
+	LeaveWaitingList(ctx context.Context, req param.LeaveWaitingListRequest) (param.LeaveWaitingListResponse, error)
+	GetWaitingStatus(ctx context.Context, req param.GetWaitingStatusRequest) (param.GetWaitingStatusResponse, error)
+}
+
 type Handler struct {
 	authConfig        authservice.Config
 	authSvc           authservice.Service
-	matchingSvc       matchingservice.Service
+	matchingClient    MatchingClient
 	matchingValidator matchingvalidator.Validator
 	presenceSvc       presenceservice.Service
 }
 
 func New(authConfig authservice.Config, authSvc authservice.Service,
-	matchingSvc matchingservice.Service,
+	matchingClient MatchingClient,
 	matchingValidator matchingvalidator.Validator,
 	presenceSvc presenceservice.Service) Handler {
 	return Handler{
 		authConfig:        authConfig,
 		authSvc:           authSvc,
-		matchingSvc:       matchingSvc,
+		matchingClient:    matchingClient,
 		matchingValidator: matchingValidator,
 		presenceSvc:       presenceSvc,
 	}
@@ -0,0 +1,22 @@
+package matchinghandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/claim"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) leaveWaitingList(c echo.Context) error {
+	claims := claim.GetClaimsFromEchoContext(c)
+
+	resp, err := h.matchingClient.LeaveWaitingList(c.Request().Context(),
+		param.LeaveWaitingListRequest{UserID: claims.UserID})
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
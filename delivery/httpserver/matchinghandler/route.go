@@ -10,4 +10,9 @@ func (h Handler) SetRoutes(e *echo.Echo) {
 
 	userGroup.POST("/add-to-waiting-list", h.addToWaitingList,
 		middleware.Auth(h.authSvc, h.authConfig), middleware.UpsertPresence(h.presenceSvc))
+	userGroup.DELETE("/waiting-list", h.leaveWaitingList,
+		middleware.Auth(h.authSvc, h.authConfig))
+	// polling the status keeps the user online, so it isn't removed from the waiting list as offline
+	userGroup.GET("/waiting-list/status", h.getWaitingStatus,
+		middleware.Auth(h.authSvc, h.authConfig), middleware.UpsertPresence(h.presenceSvc))
 }
@@ -14,7 +14,7 @@ func AccessCheck(service authorizationservice.Service,
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) (err error) {
 			claims := claim.GetClaimsFromEchoContext(c)
-			isAllowed, err := service.CheckAccess(claims.UserID, claims.Role, permissions...)
+			isAllowed, err := service.CheckAccess(c.Request().Context(), claims.UserID, claims.Role, permissions...)
 			if err != nil {
 				// TODO - log unexpected error
 				return c.JSON(http.StatusInternalServerError, echo.Map{
@@ -1,24 +1,45 @@
 package middleware
 
 import (
+	"fmt"
 	cfg "gameAppProject/config"
+	"gameAppProject/pkg/errmsg"
 	"gameAppProject/service/authservice"
 	mw "github.com/labstack/echo-jwt/v4"
 	"github.com/labstack/echo/v4"
 )
 
 func Auth(service authservice.Service, config authservice.Config) echo.MiddlewareFunc {
+	return auth(service, config, "header:Authorization:Bearer ")
+}
+
+// WebSocketAuth also accepts the token from the "token" query param,
+// browsers can't set the Authorization header on a websocket handshake.
+func WebSocketAuth(service authservice.Service, config authservice.Config) echo.MiddlewareFunc {
+	return auth(service, config, "header:Authorization:Bearer ,query:token")
+}
+
+func auth(service authservice.Service, config authservice.Config, tokenLookup string) echo.MiddlewareFunc {
 	return mw.WithConfig(mw.Config{
-		ContextKey: cfg.AuthMiddlewareContextKey,
-		SigningKey: []byte(config.SignKey),
-		// TODO - as sign method string to config...
-		SigningMethod: "HS256",
+		ContextKey:  cfg.AuthMiddlewareContextKey,
+		TokenLookup: tokenLookup,
+		// the sign method and the keys are checked by the auth service
 		ParseTokenFunc: func(c echo.Context, auth string) (interface{}, error) {
-			claims, err := service.ParseToken(auth)
+			// refresh tokens are signed with the same key, so the subject must be checked too
+			claims, err := service.ParseAccessToken(auth)
+			if err != nil {
+				return nil, err
+			}
+
+			isRevoked, err := service.IsTokenRevoked(c.Request().Context(), claims)
 			if err != nil {
 				return nil, err
 			}
 
+			if isRevoked {
+				return nil, fmt.Errorf(errmsg.ErrorMsgInvalidToken)
+			}
+
 			return claims, nil
 		},
 	})
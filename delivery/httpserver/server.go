@@ -3,55 +3,94 @@ package httpserver
 import (
 	"fmt"
 	"gameAppProject/config"
+	"gameAppProject/delivery/httpserver/authhandler"
+	"gameAppProject/delivery/httpserver/backofficeaccesscontrolhandler"
+	"gameAppProject/delivery/httpserver/backofficequestionhandler"
 	"gameAppProject/delivery/httpserver/backofficeuserhandler"
+	"gameAppProject/delivery/httpserver/gamehandler"
 	"gameAppProject/delivery/httpserver/matchinghandler"
 	"gameAppProject/delivery/httpserver/userhandler"
+	"gameAppProject/delivery/wshub"
 	"gameAppProject/service/authorizationservice"
 	"gameAppProject/service/authservice"
 	"gameAppProject/service/backofficeuserservice"
-	"gameAppProject/service/matchingservice"
+	"gameAppProject/service/gameservice"
 	"gameAppProject/service/presenceservice"
+	"gameAppProject/service/questionservice"
 	"gameAppProject/service/userservice"
+	"gameAppProject/validator/accesscontrolvalidator"
+	"gameAppProject/validator/backofficeuservalidator"
+	"gameAppProject/validator/gamevalidator"
 	"gameAppProject/validator/matchingvalidator"
+	"gameAppProject/validator/questionvalidator"
 	"gameAppProject/validator/uservalidator"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"net"
 )
 
 type Server struct {
-	config                config.Config
-	userHandler           userhandler.Handler
-	backofficeUserHandler backofficeuserhandler.Handler
-	matchingHandler       matchinghandler.Handler
-	Router                *echo.Echo
+	config                    config.Config
+	authHandler               authhandler.Handler
+	userHandler               userhandler.Handler
+	backofficeUserHandler     backofficeuserhandler.Handler
+	backofficeQuestionHandler backofficequestionhandler.Handler
+	backofficeACLHandler      backofficeaccesscontrolhandler.Handler
+	matchingHandler           matchinghandler.Handler
+	gameHandler               gamehandler.Handler
+	Router                    *echo.Echo
 }
 
 func New(config config.Config, authSvc authservice.Service, userSvc userservice.Service,
 	userValidator uservalidator.Validator,
-	backofficeUserSvc backofficeuserservice.Service, authorizationSvc authorizationservice.Service,
-	matchingSvc matchingservice.Service,
+	backofficeUserSvc backofficeuserservice.Service, backofficeUserValidator backofficeuservalidator.Validator,
+	authorizationSvc authorizationservice.Service,
+	accessControlValidator accesscontrolvalidator.Validator,
+	matchingClient matchinghandler.MatchingClient,
 	matchingValidator matchingvalidator.Validator,
-	presenceSvc presenceservice.Service) Server {
+	presenceSvc presenceservice.Service,
+	questionSvc questionservice.Service,
+	questionValidator questionvalidator.Validator,
+	gameSvc gameservice.Service,
+	gameValidator gamevalidator.Validator,
+	hub *wshub.Hub) Server {
 	return Server{
-		Router:                echo.New(),
-		config:                config,
-		userHandler:           userhandler.New(config.Auth, authSvc, userSvc, userValidator, presenceSvc),
-		backofficeUserHandler: backofficeuserhandler.New(config.Auth, authSvc, backofficeUserSvc, authorizationSvc),
-		matchingHandler:       matchinghandler.New(config.Auth, authSvc, matchingSvc, matchingValidator, presenceSvc),
+		Router:      echo.New(),
+		config:      config,
+		authHandler: authhandler.New(authSvc),
+		userHandler: userhandler.New(config.Auth, config.UserService, authSvc, userSvc, userValidator,
+			presenceSvc),
+		backofficeUserHandler: backofficeuserhandler.New(config.Auth, authSvc, backofficeUserSvc, authorizationSvc,
+			backofficeUserValidator),
+		backofficeQuestionHandler: backofficequestionhandler.New(config.Auth, authSvc, authorizationSvc,
+			questionSvc, questionValidator),
+		backofficeACLHandler: backofficeaccesscontrolhandler.New(config.Auth, authSvc, authorizationSvc,
+			accessControlValidator),
+		matchingHandler: matchinghandler.New(config.Auth, authSvc, matchingClient, matchingValidator, presenceSvc),
+		gameHandler:     gamehandler.New(config.Auth, authSvc, gameSvc, gameValidator, presenceSvc, hub),
 	}
 }
 
 func (s Server) Serve() {
+	// c.RealIP() is used by the rate limits, so the client can't choose it
+	s.Router.IPExtractor = s.ipExtractor()
+
 	// Middleware
 	s.Router.Use(middleware.Logger())
 	s.Router.Use(middleware.Recover())
 
 	// Routes
 	s.Router.GET("/health-check", s.healthCheck)
+	// the files of the local storage, e.g. avatars
+	s.Router.Static(s.config.Storage.BaseURL, s.config.Storage.BasePath)
 
+	s.authHandler.SetRoutes(s.Router)
 	s.userHandler.SetRoutes(s.Router)
 	s.backofficeUserHandler.SetRoutes(s.Router)
+	s.backofficeQuestionHandler.SetRoutes(s.Router)
+	s.backofficeACLHandler.SetRoutes(s.Router)
 	s.matchingHandler.SetRoutes(s.Router)
+	s.gameHandler.SetRoutes(s.Router)
 
 	// Start server
 	address := fmt.Sprintf(":%d", s.config.HTTPServer.Port)
@@ -60,3 +99,25 @@ func (s Server) Serve() {
 		fmt.Println("router start error", err)
 	}
 }
+
+func (s Server) ipExtractor() echo.IPExtractor {
+	if len(s.config.HTTPServer.TrustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range s.config.HTTPServer.TrustedProxies {
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			panic(fmt.Errorf("invalid trusted proxy %s: %w", proxy, err))
+		}
+
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...)
+}
@@ -0,0 +1,41 @@
+package userhandler
+
+import (
+	"fmt"
+	"gameAppProject/param"
+	"gameAppProject/pkg/claim"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) uploadAvatar(c echo.Context) error {
+	fileHeader, err := c.FormFile("avatar")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			// TODO - log error
+			fmt.Println("avatar file close error", err)
+		}
+	}()
+
+	claims := claim.GetClaimsFromEchoContext(c)
+
+	resp, err := h.userSvc.UploadAvatar(c.Request().Context(), param.UploadAvatarRequest{
+		UserID: claims.UserID,
+		File:   file,
+	})
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -9,17 +9,19 @@ import (
 
 type Handler struct {
 	authConfig    authservice.Config
+	userConfig    userservice.Config
 	authSvc       authservice.Service
 	userSvc       userservice.Service
 	userValidator uservalidator.Validator
 	presenceSvc   presenceservice.Service
 }
 
-func New(authConfig authservice.Config, authSvc authservice.Service,
+func New(authConfig authservice.Config, userConfig userservice.Config, authSvc authservice.Service,
 	userSvc userservice.Service,
 	userValidator uservalidator.Validator, presenceSvc presenceservice.Service) Handler {
 	return Handler{
 		authConfig:    authConfig,
+		userConfig:    userConfig,
 		authSvc:       authSvc,
 		userSvc:       userSvc,
 		userValidator: userValidator,
@@ -21,9 +21,12 @@ func (h Handler) userLogin(c echo.Context) error {
 		})
 	}
 
-	resp, err := h.userSvc.Login(req)
+	req.IP = c.RealIP()
+
+	resp, err := h.userSvc.Login(c.Request().Context(), req)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
 	}
 
 	return c.JSON(http.StatusOK, resp)
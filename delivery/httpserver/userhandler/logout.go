@@ -0,0 +1,38 @@
+package userhandler
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/claim"
+	"gameAppProject/pkg/httpmsg"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+func (h Handler) userLogout(c echo.Context) error {
+	var req param.LogoutRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	claims := claim.GetClaimsFromEchoContext(c)
+
+	resp, err := h.userSvc.Logout(c.Request().Context(), claims, req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h Handler) userLogoutAll(c echo.Context) error {
+	claims := claim.GetClaimsFromEchoContext(c)
+
+	resp, err := h.userSvc.LogoutAll(c.Request().Context(), param.LogoutAllRequest{UserID: claims.UserID})
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -19,3 +19,29 @@ func (h Handler) userProfile(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, resp)
 }
+
+func (h Handler) updateProfile(c echo.Context) error {
+	var req param.UpdateProfileRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	claims := claim.GetClaimsFromEchoContext(c)
+	req.UserID = claims.UserID
+
+	if fieldErrors, err := h.userValidator.ValidateUpdateProfileRequest(req); err != nil {
+		msg, code := httpmsg.Error(err)
+		return c.JSON(code, echo.Map{
+			"message": msg,
+			"errors":  fieldErrors,
+		})
+	}
+
+	resp, err := h.userSvc.UpdateProfile(c.Request().Context(), req)
+	if err != nil {
+		msg, code := httpmsg.Error(err)
+		return echo.NewHTTPError(code, msg)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
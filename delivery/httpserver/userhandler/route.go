@@ -3,13 +3,36 @@ package userhandler
 import (
 	"gameAppProject/delivery/httpserver/middleware"
 	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"strconv"
 )
 
+// avatarFormOverhead is the size of the multipart form around the avatar file, e.g. the boundaries and the headers.
+const avatarFormOverhead = 4 << 10
+
 func (h Handler) SetRoutes(e *echo.Echo) {
 	userGroup := e.Group("/users")
 	userGroup.GET("/profile", h.userProfile,
 		middleware.Auth(h.authSvc, h.authConfig),
 		middleware.UpsertPresence(h.presenceSvc))
+	userGroup.PATCH("/profile", h.updateProfile,
+		middleware.Auth(h.authSvc, h.authConfig),
+		middleware.UpsertPresence(h.presenceSvc))
+	userGroup.PUT("/profile/avatar", h.uploadAvatar,
+		// the body is limited before c.FormFile, which would store a larger file on the disk
+		echomiddleware.BodyLimit(strconv.FormatInt(h.userConfig.AvatarMaxSize+avatarFormOverhead, 10)),
+		middleware.Auth(h.authSvc, h.authConfig),
+		middleware.UpsertPresence(h.presenceSvc))
 	userGroup.POST("/login", h.userLogin)
+	userGroup.POST("/login-code", h.sendLoginCode)
+	userGroup.POST("/login-with-otp", h.userLoginWithOTP)
 	userGroup.POST("/register", h.userRegister)
+	userGroup.POST("/verification-code", h.sendVerificationCode)
+	userGroup.POST("/verify-phone-number", h.verifyPhoneNumber)
+	userGroup.POST("/refresh-token", h.userRefreshToken)
+	userGroup.POST("/logout", h.userLogout, middleware.Auth(h.authSvc, h.authConfig))
+	userGroup.POST("/logout-all", h.userLogoutAll, middleware.Auth(h.authSvc, h.authConfig))
+	userGroup.POST("/change-password", h.changePassword, middleware.Auth(h.authSvc, h.authConfig))
+	userGroup.POST("/reset-password-code", h.sendResetPasswordCode)
+	userGroup.POST("/reset-password", h.resetPassword)
 }
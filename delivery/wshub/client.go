@@ -0,0 +1,112 @@
+package wshub
+
+import (
+	"encoding/json"
+	"fmt"
+	"gameAppProject/param"
+	"github.com/gorilla/websocket"
+	"time"
+)
+
+const (
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
+	pingPeriod     = (pongWait * 9) / 10
+	maxMessageSize = 4096
+	sendBufferSize = 32
+)
+
+// Client is one websocket connection of a user.
+type Client struct {
+	userID uint
+	conn   *websocket.Conn
+	send   chan []byte
+}
+
+func NewClient(userID uint, conn *websocket.Conn) *Client {
+	return &Client{userID: userID, conn: conn, send: make(chan []byte, sendBufferSize)}
+}
+
+func (c *Client) UserID() uint {
+	return c.userID
+}
+
+// Send queues an event only for this connection.
+func (c *Client) Send(event param.GameEvent) {
+	message, err := json.Marshal(event)
+	if err != nil {
+		// TODO - log error
+		fmt.Println("wshub.Client.Send marshal error", err)
+
+		return
+	}
+
+	c.enqueue(message)
+}
+
+// enqueue never blocks, a client that can't keep up is disconnected.
+func (c *Client) enqueue(message []byte) {
+	select {
+	case c.send <- message:
+	default:
+		c.conn.Close()
+	}
+}
+
+// ReadPump reads messages until the connection is closed and passes them to handle,
+// onPong is called for every pong so an idle connection is still known to be alive.
+func (c *Client) ReadPump(handle func(message []byte), onPong func()) {
+	defer c.conn.Close()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		onPong()
+
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, message, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				// TODO - log error
+				fmt.Println("wshub.Client.ReadPump error", err)
+			}
+
+			return
+		}
+
+		handle(message)
+	}
+}
+
+// WritePump writes queued messages and pings until the send channel is closed by the hub.
+func (c *Client) WritePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case message, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+
+				return
+			}
+
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package wshub
+
+import (
+	"encoding/json"
+	"fmt"
+	"gameAppProject/param"
+	"sync"
+)
+
+// Hub keeps the websocket clients of this instance grouped by user id.
+type Hub struct {
+	mu      sync.RWMutex
+	clients map[uint]map[*Client]struct{}
+}
+
+func New() *Hub {
+	return &Hub{clients: make(map[uint]map[*Client]struct{})}
+}
+
+func (h *Hub) Register(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.clients[c.userID] == nil {
+		h.clients[c.userID] = make(map[*Client]struct{})
+	}
+
+	h.clients[c.userID][c] = struct{}{}
+}
+
+func (h *Hub) Unregister(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[c.userID][c]; !ok {
+		return
+	}
+
+	delete(h.clients[c.userID], c)
+	if len(h.clients[c.userID]) == 0 {
+		delete(h.clients, c.userID)
+	}
+
+	close(c.send)
+}
+
+// Notify sends the event to every connection of the given users,
+// users without an open connection just miss the event.
+func (h *Hub) Notify(userIDs []uint, event param.GameEvent) {
+	message, err := json.Marshal(event)
+	if err != nil {
+		// TODO - log error
+		fmt.Println("wshub.Notify marshal error", err)
+
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, userID := range userIDs {
+		for c := range h.clients[userID] {
+			c.enqueue(message)
+		}
+	}
+}
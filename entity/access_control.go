@@ -14,3 +14,7 @@ const (
 	RoleActorType = "role"
 	UserActorType = "user"
 )
+
+func (a ActorType) IsValid() bool {
+	return a == RoleActorType || a == UserActorType
+}
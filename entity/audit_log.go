@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+type AuditAction string
+
+const (
+	AuditActionLoginLockout = AuditAction("login_lockout")
+)
+
+// AuditLog records a security related event, the fields that don't apply to the action are empty.
+type AuditLog struct {
+	ID          uint
+	Action      AuditAction
+	PhoneNumber string
+	IP          string
+	Description string
+	CreatedAt   time.Time
+}
@@ -9,7 +9,7 @@ const (
 
 func (c Category) IsValid() bool {
 	switch c {
-	case FootballCategory:
+	case FootballCategory, HistoryCategory:
 		return true
 	}
 
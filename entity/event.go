@@ -0,0 +1,51 @@
+package entity
+
+type EventType string
+
+const (
+	UserRegisteredEvent = EventType("user.registered")
+	UsersMatchedEvent   = EventType("matching.users_matched")
+	GameStartedEvent    = EventType("game.started")
+	GameFinishedEvent   = EventType("game.finished")
+
+	AccessControlGrantedEvent = EventType("access_control.granted")
+	AccessControlRevokedEvent = EventType("access_control.revoked")
+)
+
+// the payloads only get new fields, a breaking change needs a new event version.
+
+type UserRegistered struct {
+	UserID      uint   `json:"user_id"`
+	PhoneNumber string `json:"phone_number"`
+	Name        string `json:"name"`
+}
+
+type UsersMatched struct {
+	Category Category `json:"category"`
+	UserIDs  []uint   `json:"user_ids"`
+}
+
+type GameStarted struct {
+	GameID   uint     `json:"game_id"`
+	Category Category `json:"category"`
+	UserIDs  []uint   `json:"user_ids"`
+}
+
+type GameFinished struct {
+	GameID       uint          `json:"game_id"`
+	Category     Category      `json:"category"`
+	WinnerUserID uint          `json:"winner_user_id"`
+	Scores       []PlayerScore `json:"scores"`
+}
+
+type PlayerScore struct {
+	UserID uint `json:"user_id"`
+	Score  uint `json:"score"`
+}
+
+type AccessControlChanged struct {
+	ID           uint      `json:"id"`
+	ActorType    ActorType `json:"actor_type"`
+	ActorID      uint      `json:"actor_id"`
+	PermissionID uint      `json:"permission_id"`
+}
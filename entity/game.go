@@ -7,7 +7,13 @@ type Game struct {
 	Category    Category
 	QuestionIDs []uint
 	PlayerIDs   []uint
+	WinnerID    uint // player id of the winner, zero means no winner yet or a draw
 	StartTime   time.Time
+	EndTime     time.Time
+}
+
+func (g Game) IsFinished() bool {
+	return !g.EndTime.IsZero()
 }
 
 type Player struct {
@@ -23,8 +29,9 @@ type PlayerAnswer struct {
 	PlayerID   uint
 	QuestionID uint
 	Choice     PossibleAnswerChoice
+	IsCorrect  bool
 }
 
 func data() {
 
-}
\ No newline at end of file
+}
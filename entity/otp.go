@@ -0,0 +1,10 @@
+package entity
+
+// OTPPurpose scopes a one-time code, a code that is sent for one purpose can't be used for another.
+type OTPPurpose string
+
+const (
+	OTPPurposeVerifyPhoneNumber = OTPPurpose("verify_phone_number")
+	OTPPurposeLogin             = OTPPurpose("login")
+	OTPPurposeResetPassword     = OTPPurpose("reset_password")
+)
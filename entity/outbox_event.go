@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// OutboxEvent is an event that is saved with the change, Payload is the whole encoded event.
+type OutboxEvent struct {
+	ID            uint
+	EventID       string
+	EventType     EventType
+	AggregateID   string
+	Payload       []byte
+	Attempts      int
+	NextAttemptAt time.Time
+}
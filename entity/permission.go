@@ -8,6 +8,17 @@ type Permission struct {
 type PermissionTitle string
 
 const (
-	UserListPermission   = PermissionTitle("user-list")
-	UserDeletePermission = PermissionTitle("user-delete")
+	UserListPermission        = PermissionTitle("user-list")
+	UserDeletePermission      = PermissionTitle("user-delete")
+	QuestionCreatePermission  = PermissionTitle("question-create")
+	QuestionUpdatePermission  = PermissionTitle("question-update")
+	QuestionDeletePermission  = PermissionTitle("question-delete")
+	QuestionListPermission    = PermissionTitle("question-list")
+	UserForceLogoutPermission = PermissionTitle("user-force-logout")
+	UserBanPermission         = PermissionTitle("user-ban")
+	UserRoleUpdatePermission  = PermissionTitle("user-role-update")
+	// AccessControlManagePermission is for super admins, it allows changing the ACLs of everyone
+	AccessControlManagePermission = PermissionTitle("access-control-manage")
+	// AdminRoleManagePermission is for super admins, it allows giving or taking the admin roles
+	AdminRoleManagePermission = PermissionTitle("admin-role-manage")
 )
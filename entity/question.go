@@ -4,9 +4,9 @@ type Question struct {
 	ID              uint
 	Text            string
 	PossibleAnswers []PossibleAnswer
-	CorrectAnswer   uint
+	CorrectAnswer   PossibleAnswerChoice
 	Difficulty      QuestionDifficulty
-	CategoryID      uint
+	Category        Category
 }
 
 type PossibleAnswer struct {
@@ -24,7 +24,7 @@ const (
 	PossibleAnswerD
 )
 
-func (c PossibleAnswerChoice) isValid() bool {
+func (c PossibleAnswerChoice) IsValid() bool {
 	if c >= PossibleAnswerA && c <= PossibleAnswerD {
 
 		return true
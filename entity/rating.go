@@ -0,0 +1,16 @@
+package entity
+
+// Rating is the elo rating of a user in a category.
+type Rating struct {
+	UserID      uint
+	Category    Category
+	Rating      int
+	GamesPlayed uint
+}
+
+// RatingChange is added to the stored rating of a user, so concurrent changes don't overwrite each other.
+type RatingChange struct {
+	UserID   uint
+	Category Category
+	Delta    int
+}
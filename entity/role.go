@@ -5,11 +5,13 @@ type Role uint8
 const (
 	UserRole Role = iota + 1
 	AdminRole
+	SuperAdminRole
 )
 
 const (
-	UserRoleStr  = "user"
-	AdminRoleStr = "admin"
+	UserRoleStr       = "user"
+	AdminRoleStr      = "admin"
+	SuperAdminRoleStr = "super_admin"
 )
 
 func (r Role) String() string {
@@ -18,17 +20,26 @@ func (r Role) String() string {
 		return UserRoleStr
 	case AdminRole:
 		return AdminRoleStr
+	case SuperAdminRole:
+		return SuperAdminRoleStr
 	}
 
 	return ""
 }
 
+// IsHigherThan compares the ranks of the roles, the roles are declared from the lowest to the highest.
+func (r Role) IsHigherThan(other Role) bool {
+	return r > other
+}
+
 func MapToRoleEntity(roleStr string) Role {
 	switch roleStr {
 	case UserRoleStr:
 		return UserRole
 	case AdminRoleStr:
 		return AdminRole
+	case SuperAdminRoleStr:
+		return SuperAdminRole
 	}
 
 	return Role(0)
@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 // User represents a user entity with basic information.
 type User struct {
 	ID          uint   // Unique identifier for the user.
@@ -7,4 +9,16 @@ type User struct {
 	Name        string // User's name.
 	Password    string //User's always keep hashed password.
 	Role        Role
+	VerifiedAt  time.Time // zero until the phone number is verified.
+	Avatar      string    // storage key of the avatar, empty if the user has no avatar.
+	BannedAt    time.Time // zero if the user is not banned.
+	CreatedAt   time.Time
+}
+
+func (u User) IsVerified() bool {
+	return !u.VerifiedAt.IsZero()
+}
+
+func (u User) IsBanned() bool {
+	return !u.BannedAt.IsZero()
 }
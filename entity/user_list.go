@@ -0,0 +1,32 @@
+package entity
+
+// UserFilter narrows the user list, the empty fields are ignored.
+type UserFilter struct {
+	Role Role
+	// PhoneNumber matches the phone numbers that start with it
+	PhoneNumber string
+	// Name matches the names that contain it
+	Name string
+}
+
+type UserSortField string
+
+const (
+	UserSortByID        = UserSortField("id")
+	UserSortByName      = UserSortField("name")
+	UserSortByCreatedAt = UserSortField("created_at")
+)
+
+func (f UserSortField) IsValid() bool {
+	switch f {
+	case UserSortByID, UserSortByName, UserSortByCreatedAt:
+		return true
+	}
+
+	return false
+}
+
+type UserSort struct {
+	Field      UserSortField
+	Descending bool
+}
@@ -0,0 +1,19 @@
+package entity
+
+// CategoryStats is the summary of the finished games of a user in a category.
+type CategoryStats struct {
+	Category          Category
+	GamesPlayed       uint
+	Wins              uint
+	AnsweredQuestions uint
+	CorrectAnswers    uint
+}
+
+// Accuracy is the ratio of the correct answers to the answered questions.
+func (c CategoryStats) Accuracy() float64 {
+	if c.AnsweredQuestions == 0 {
+		return 0
+	}
+
+	return float64(c.CorrectAnswers) / float64(c.AnsweredQuestions)
+}
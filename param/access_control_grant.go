@@ -0,0 +1,13 @@
+package param
+
+import "gameAppProject/entity"
+
+type AccessControlGrantRequest struct {
+	ActorType    entity.ActorType `json:"actor_type"`
+	ActorID      uint             `json:"actor_id"`
+	PermissionID uint             `json:"permission_id"`
+}
+
+type AccessControlGrantResponse struct {
+	AccessControl AccessControlInfo `json:"access_control"`
+}
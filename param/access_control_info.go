@@ -0,0 +1,15 @@
+package param
+
+import "gameAppProject/entity"
+
+type PermissionInfo struct {
+	ID    uint                   `json:"id"`
+	Title entity.PermissionTitle `json:"title"`
+}
+
+type AccessControlInfo struct {
+	ID           uint             `json:"id"`
+	ActorType    entity.ActorType `json:"actor_type"`
+	ActorID      uint             `json:"actor_id"`
+	PermissionID uint             `json:"permission_id"`
+}
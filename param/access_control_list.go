@@ -0,0 +1,12 @@
+package param
+
+import "gameAppProject/entity"
+
+type AccessControlListRequest struct {
+	ActorType entity.ActorType `query:"actor_type"`
+	ActorID   uint             `query:"actor_id"`
+}
+
+type AccessControlListResponse struct {
+	AccessControls []AccessControlInfo `json:"access_controls"`
+}
@@ -0,0 +1,9 @@
+package param
+
+type PermissionCreateRequest struct {
+	Title string `json:"title"`
+}
+
+type PermissionCreateResponse struct {
+	Permission PermissionInfo `json:"permission"`
+}
@@ -0,0 +1,7 @@
+package param
+
+type PermissionListRequest struct{}
+
+type PermissionListResponse struct {
+	Permissions []PermissionInfo `json:"permissions"`
+}
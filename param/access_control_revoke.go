@@ -0,0 +1,11 @@
+package param
+
+import "gameAppProject/entity"
+
+type AccessControlRevokeRequest struct {
+	AdminID   uint
+	AdminRole entity.Role
+	ID        uint `param:"id"`
+}
+
+type AccessControlRevokeResponse struct{}
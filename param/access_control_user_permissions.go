@@ -0,0 +1,11 @@
+package param
+
+type UserPermissionsRequest struct {
+	UserID uint `param:"id"`
+}
+
+type UserPermissionsResponse struct {
+	UserID      uint             `json:"user_id"`
+	Role        string           `json:"role"`
+	Permissions []PermissionInfo `json:"permissions"`
+}
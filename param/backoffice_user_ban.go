@@ -0,0 +1,19 @@
+package param
+
+import "gameAppProject/entity"
+
+type BanUserRequest struct {
+	ActorID   uint
+	ActorRole entity.Role
+	UserID    uint `param:"id"`
+}
+
+type BanUserResponse struct{}
+
+type UnbanUserRequest struct {
+	ActorID   uint
+	ActorRole entity.Role
+	UserID    uint `param:"id"`
+}
+
+type UnbanUserResponse struct{}
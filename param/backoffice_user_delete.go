@@ -0,0 +1,11 @@
+package param
+
+import "gameAppProject/entity"
+
+type BackofficeUserDeleteRequest struct {
+	ActorID   uint
+	ActorRole entity.Role
+	UserID    uint `param:"id"`
+}
+
+type BackofficeUserDeleteResponse struct{}
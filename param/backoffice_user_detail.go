@@ -0,0 +1,9 @@
+package param
+
+type BackofficeUserDetailRequest struct {
+	UserID uint `param:"id"`
+}
+
+type BackofficeUserDetailResponse struct {
+	User BackofficeUserInfo `json:"user"`
+}
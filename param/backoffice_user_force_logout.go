@@ -0,0 +1,11 @@
+package param
+
+import "gameAppProject/entity"
+
+type ForceLogoutRequest struct {
+	ActorID   uint
+	ActorRole entity.Role
+	UserID    uint `param:"id"`
+}
+
+type ForceLogoutResponse struct{}
@@ -0,0 +1,14 @@
+package param
+
+import "time"
+
+// BackofficeUserInfo is the user in the backoffice responses, it never has the password.
+type BackofficeUserInfo struct {
+	ID          uint       `json:"id"`
+	Name        string     `json:"name"`
+	PhoneNumber string     `json:"phone_number"`
+	Role        string     `json:"role"`
+	VerifiedAt  *time.Time `json:"verified_at"`
+	BannedAt    *time.Time `json:"banned_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
@@ -0,0 +1,17 @@
+package param
+
+type BackofficeUserListRequest struct {
+	Role        string `query:"role"`
+	PhoneNumber string `query:"phone_number"`
+	Name        string `query:"name"`
+	// SortBy is one of id, name or created_at, SortOrder is asc or desc
+	SortBy    string `query:"sort_by"`
+	SortOrder string `query:"sort_order"`
+	Page      uint   `query:"page"`
+	PageSize  uint   `query:"page_size"`
+}
+
+type BackofficeUserListResponse struct {
+	Users []BackofficeUserInfo `json:"users"`
+	Total uint                 `json:"total"`
+}
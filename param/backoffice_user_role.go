@@ -0,0 +1,14 @@
+package param
+
+import "gameAppProject/entity"
+
+type ChangeRoleRequest struct {
+	ActorID   uint
+	ActorRole entity.Role
+	UserID    uint   `param:"id"`
+	Role      string `json:"role"`
+}
+
+type ChangeRoleResponse struct {
+	User BackofficeUserInfo `json:"user"`
+}
@@ -0,0 +1,17 @@
+package param
+
+import "gameAppProject/entity"
+
+type AnswerQuestionRequest struct {
+	UserID     uint                        `json:"user_id"`
+	GameID     uint                        `json:"game_id"`
+	QuestionID uint                        `json:"question_id"`
+	Choice     entity.PossibleAnswerChoice `json:"choice"`
+}
+
+type AnswerQuestionResponse struct {
+	IsCorrect      bool `json:"is_correct"`
+	Score          uint `json:"score"`
+	IsGameFinished bool `json:"is_game_finished"`
+	WinnerUserID   uint `json:"winner_user_id"`
+}
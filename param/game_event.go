@@ -0,0 +1,73 @@
+package param
+
+import (
+	"encoding/json"
+	"gameAppProject/entity"
+)
+
+type GameEventType string
+
+const (
+	GameEventMatched          = GameEventType("matched")
+	GameEventQuestion         = GameEventType("question")
+	GameEventOpponentAnswered = GameEventType("opponent_answered")
+	GameEventGameFinished     = GameEventType("game_finished")
+	GameEventAnswerResult     = GameEventType("answer_result")
+	GameEventError            = GameEventType("error")
+)
+
+type GameMessageType string
+
+const (
+	GameMessageAnswer = GameMessageType("answer")
+)
+
+// GameMessage is the envelope of every message that is sent by the players.
+type GameMessage struct {
+	Type    GameMessageType `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// GameEvent is the envelope of every message that is pushed to the players.
+type GameEvent struct {
+	Type    GameEventType `json:"type"`
+	Payload interface{}   `json:"payload"`
+}
+
+type MatchedEventPayload struct {
+	GameID          uint            `json:"game_id"`
+	Category        entity.Category `json:"category"`
+	OpponentUserIDs []uint          `json:"opponent_user_ids"`
+	QuestionCount   int             `json:"question_count"`
+}
+
+// QuestionEventPayload never contains the correct answer.
+type QuestionEventPayload struct {
+	GameID          uint                      `json:"game_id"`
+	QuestionID      uint                      `json:"question_id"`
+	Text            string                    `json:"text"`
+	PossibleAnswers []PossibleAnswerInfo      `json:"possible_answers"`
+	Difficulty      entity.QuestionDifficulty `json:"difficulty"`
+}
+
+type OpponentAnsweredEventPayload struct {
+	GameID     uint `json:"game_id"`
+	QuestionID uint `json:"question_id"`
+	UserID     uint `json:"user_id"`
+}
+
+type GameFinishedEventPayload struct {
+	GameID       uint          `json:"game_id"`
+	WinnerUserID uint          `json:"winner_user_id"`
+	Scores       []PlayerScore `json:"scores"`
+}
+
+type PlayerScore struct {
+	UserID uint `json:"user_id"`
+	Score  uint `json:"score"`
+}
+
+type ErrorEventPayload struct {
+	Message string            `json:"message"`
+	Errors  map[string]string `json:"errors,omitempty"`
+}
@@ -0,0 +1,13 @@
+package param
+
+import "gameAppProject/entity"
+
+type StartGameRequest struct {
+	Category entity.Category
+	UserIDs  []uint
+}
+
+type StartGameResponse struct {
+	GameID    uint
+	PlayerIDs []uint
+}
@@ -0,0 +1,16 @@
+package param
+
+type GetUserStatsRequest struct {
+	UserID uint
+}
+
+type GetUserStatsResponse struct {
+	Items []CategoryStats `json:"items"`
+}
+
+type CategoryStats struct {
+	Category    string  `json:"category"`
+	GamesPlayed uint    `json:"games_played"`
+	Wins        uint    `json:"wins"`
+	Accuracy    float64 `json:"accuracy"`
+}
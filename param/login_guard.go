@@ -0,0 +1,26 @@
+package param
+
+import "time"
+
+type CheckLoginRequest struct {
+	PhoneNumber string
+	IP          string
+}
+
+type CheckLoginResponse struct {
+	// RetryAfter is zero if the login is not locked
+	RetryAfter time.Duration
+}
+
+type LoginFailedRequest struct {
+	PhoneNumber string
+	IP          string
+}
+
+type LoginFailedResponse struct{}
+
+type LoginSucceededRequest struct {
+	PhoneNumber string
+}
+
+type LoginSucceededResponse struct{}
@@ -0,0 +1,14 @@
+package param
+
+import "gameAppProject/entity"
+
+type ClaimMatchedUsersRequest struct {
+	Category entity.Category
+	UserIDs  []uint
+}
+
+// ClaimMatchedUsersResponse has the claimed users with the time they have joined the waiting list.
+type ClaimMatchedUsersResponse struct {
+	Claimed bool
+	Users   []entity.WaitingMember
+}
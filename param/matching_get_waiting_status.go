@@ -0,0 +1,19 @@
+package param
+
+import (
+	"gameAppProject/entity"
+	"time"
+)
+
+type GetWaitingStatusRequest struct {
+	UserID uint `json:"user_id"`
+}
+
+type GetWaitingStatusResponse struct {
+	Category         entity.Category `json:"category"`
+	WaitingTime      time.Duration   `json:"waiting_time_in_nanoseconds"`
+	RemainingTimeout time.Duration   `json:"remaining_timeout_in_nanoseconds"`
+	// Position is 1 for the user that has waited the longest
+	Position  int `json:"position"`
+	QueueSize int `json:"queue_size"`
+}
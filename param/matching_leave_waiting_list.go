@@ -0,0 +1,7 @@
+package param
+
+type LeaveWaitingListRequest struct {
+	UserID uint `json:"user_id"`
+}
+
+type LeaveWaitingListResponse struct{}
@@ -0,0 +1,11 @@
+package param
+
+import "gameAppProject/entity"
+
+// RestoreMatchedUsersRequest has the users as they were claimed, so they keep their turn.
+type RestoreMatchedUsersRequest struct {
+	Category entity.Category
+	Users    []entity.WaitingMember
+}
+
+type RestoreMatchedUsersResponse struct{}
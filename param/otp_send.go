@@ -0,0 +1,10 @@
+package param
+
+import "gameAppProject/entity"
+
+type SendOTPRequest struct {
+	PhoneNumber string
+	Purpose     entity.OTPPurpose
+}
+
+type SendOTPResponse struct{}
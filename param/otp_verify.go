@@ -0,0 +1,11 @@
+package param
+
+import "gameAppProject/entity"
+
+type VerifyOTPRequest struct {
+	PhoneNumber string
+	Purpose     entity.OTPPurpose
+	Code        string
+}
+
+type VerifyOTPResponse struct{}
@@ -0,0 +1,9 @@
+package param
+
+type RelayOutboxRequest struct{}
+
+type RelayOutboxResponse struct {
+	Published int
+	Failed    int
+	Dead      int
+}
@@ -0,0 +1,15 @@
+package param
+
+import "gameAppProject/entity"
+
+type QuestionCreateRequest struct {
+	Text            string                      `json:"text"`
+	PossibleAnswers []PossibleAnswerInfo        `json:"possible_answers"`
+	CorrectAnswer   entity.PossibleAnswerChoice `json:"correct_answer"`
+	Difficulty      entity.QuestionDifficulty   `json:"difficulty"`
+	Category        entity.Category             `json:"category"`
+}
+
+type QuestionCreateResponse struct {
+	Question QuestionInfo `json:"question"`
+}
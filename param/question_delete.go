@@ -0,0 +1,7 @@
+package param
+
+type QuestionDeleteRequest struct {
+	ID uint `param:"id"`
+}
+
+type QuestionDeleteResponse struct{}
@@ -0,0 +1,17 @@
+package param
+
+import "gameAppProject/entity"
+
+type QuestionInfo struct {
+	ID              uint                        `json:"id"`
+	Text            string                      `json:"text"`
+	PossibleAnswers []PossibleAnswerInfo        `json:"possible_answers"`
+	CorrectAnswer   entity.PossibleAnswerChoice `json:"correct_answer"`
+	Difficulty      entity.QuestionDifficulty   `json:"difficulty"`
+	Category        entity.Category             `json:"category"`
+}
+
+type PossibleAnswerInfo struct {
+	Text   string                      `json:"text"`
+	Choice entity.PossibleAnswerChoice `json:"choice"`
+}
@@ -0,0 +1,14 @@
+package param
+
+import "gameAppProject/entity"
+
+type QuestionListRequest struct {
+	Category entity.Category `query:"category"`
+	Page     uint            `query:"page"`
+	PageSize uint            `query:"page_size"`
+}
+
+type QuestionListResponse struct {
+	Questions []QuestionInfo `json:"questions"`
+	Total     uint           `json:"total"`
+}
@@ -0,0 +1,16 @@
+package param
+
+import "gameAppProject/entity"
+
+type QuestionUpdateRequest struct {
+	ID              uint                        `json:"-" param:"id"`
+	Text            string                      `json:"text"`
+	PossibleAnswers []PossibleAnswerInfo        `json:"possible_answers"`
+	CorrectAnswer   entity.PossibleAnswerChoice `json:"correct_answer"`
+	Difficulty      entity.QuestionDifficulty   `json:"difficulty"`
+	Category        entity.Category             `json:"category"`
+}
+
+type QuestionUpdateResponse struct {
+	Question QuestionInfo `json:"question"`
+}
@@ -0,0 +1,14 @@
+package param
+
+import "time"
+
+type RateLimitRequest struct {
+	Key    string
+	Limit  int64
+	Window time.Duration
+}
+
+type RateLimitResponse struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
@@ -0,0 +1,17 @@
+package param
+
+import "gameAppProject/entity"
+
+type GetRatingsRequest struct {
+	Category entity.Category
+	UserIDs  []uint
+}
+
+type GetRatingsResponse struct {
+	Items []GetRatingItem
+}
+
+type GetRatingItem struct {
+	UserID uint
+	Rating int
+}
@@ -0,0 +1,11 @@
+package param
+
+import "gameAppProject/entity"
+
+type UpdateRatingsRequest struct {
+	GameID   uint
+	Category entity.Category
+	Scores   []PlayerScore
+}
+
+type UpdateRatingsResponse struct{}
@@ -0,0 +1,13 @@
+package param
+
+import "io"
+
+type UploadAvatarRequest struct {
+	UserID uint
+	File   io.Reader
+}
+
+type UploadAvatarResponse struct {
+	Avatar          string `json:"avatar"`
+	AvatarThumbnail string `json:"avatar_thumbnail"`
+}
@@ -0,0 +1,9 @@
+package param
+
+type ChangePasswordRequest struct {
+	UserID      uint
+	OldPassword string `json:"old_password"`
+	NewPassword string `json:"new_password"`
+}
+
+type ChangePasswordResponse struct{}
@@ -3,6 +3,7 @@ package param
 type LoginRequest struct {
 	PhoneNumber string `json:"phone_number"`
 	Password    string `json:"password"`
+	IP          string
 }
 
 type LoginResponse struct {
@@ -0,0 +1,19 @@
+package param
+
+type SendLoginCodeRequest struct {
+	PhoneNumber string `json:"phone_number"`
+	IP          string
+}
+
+type SendLoginCodeResponse struct{}
+
+type LoginWithOTPRequest struct {
+	PhoneNumber string `json:"phone_number"`
+	Code        string `json:"code"`
+	IP          string
+}
+
+type LoginWithOTPResponse struct {
+	User   UserInfo `json:"user"`
+	Tokens Tokens   `json:"tokens"`
+}
@@ -0,0 +1,14 @@
+package param
+
+type LogoutRequest struct {
+	// RefreshToken is optional, if it's given it's revoked with the access token
+	RefreshToken string `json:"refresh_token"`
+}
+
+type LogoutResponse struct{}
+
+type LogoutAllRequest struct {
+	UserID uint
+}
+
+type LogoutAllResponse struct{}
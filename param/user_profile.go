@@ -5,5 +5,17 @@ type ProfileRequest struct {
 }
 
 type ProfileResponse struct {
+	Name            string          `json:"name"`
+	Avatar          string          `json:"avatar"`
+	AvatarThumbnail string          `json:"avatar_thumbnail"`
+	Stats           []CategoryStats `json:"stats"`
+}
+
+type UpdateProfileRequest struct {
+	UserID uint
+	Name   string `json:"name"`
+}
+
+type UpdateProfileResponse struct {
 	Name string `json:"name"`
 }
@@ -0,0 +1,9 @@
+package param
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type RefreshTokenResponse struct {
+	Tokens Tokens `json:"tokens"`
+}
@@ -0,0 +1,17 @@
+package param
+
+type SendResetPasswordCodeRequest struct {
+	PhoneNumber string `json:"phone_number"`
+	IP          string
+}
+
+type SendResetPasswordCodeResponse struct{}
+
+type ResetPasswordRequest struct {
+	PhoneNumber string `json:"phone_number"`
+	Code        string `json:"code"`
+	NewPassword string `json:"new_password"`
+	IP          string
+}
+
+type ResetPasswordResponse struct{}
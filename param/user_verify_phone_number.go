@@ -0,0 +1,16 @@
+package param
+
+type SendVerificationCodeRequest struct {
+	PhoneNumber string `json:"phone_number"`
+	IP          string
+}
+
+type SendVerificationCodeResponse struct{}
+
+type VerifyPhoneNumberRequest struct {
+	PhoneNumber string `json:"phone_number"`
+	Code        string `json:"code"`
+	IP          string
+}
+
+type VerifyPhoneNumberResponse struct{}
@@ -1,12 +1,41 @@
 package errmsg
 
 const (
-	ErrorMsgNotFound               = "record not found"
-	ErrorMsgCantScanQueryResult    = "can't scan query result"
-	ErrorMsgSomethingWentWrong     = "something went wrong"
-	ErrorMsgPhoneNumberIsNotUnique = "phone number is not unique"
-	ErrorMsgInvalidInput           = "invalid input"
-	ErrorMsgPhoneNumberIsNotValid  = "phone number is not valid"
-	ErrorMsgUserNotAllowed         = "user not allowed"
-	ErrorMsgCategoryIsNotValid = "category is not valid"
+	ErrorMsgNotFound                   = "record not found"
+	ErrorMsgCantScanQueryResult        = "can't scan query result"
+	ErrorMsgSomethingWentWrong         = "something went wrong"
+	ErrorMsgPhoneNumberIsNotUnique     = "phone number is not unique"
+	ErrorMsgInvalidInput               = "invalid input"
+	ErrorMsgPhoneNumberIsNotValid      = "phone number is not valid"
+	ErrorMsgUserNotAllowed             = "user not allowed"
+	ErrorMsgCategoryIsNotValid         = "category is not valid"
+	ErrorMsgNoQuestionForCategory      = "there is no question for this category"
+	ErrorMsgUsersAreNotWaiting         = "users are not in the waiting list"
+	ErrorMsgUserIsNotWaiting           = "user is not in the waiting list"
+	ErrorMsgUserIsWaitingElsewhere     = "user is already in the waiting list of another category"
+	ErrorMsgDifficultyIsNotValid       = "difficulty is not valid"
+	ErrorMsgPossibleAnswersInvalid     = "possible answers are not valid"
+	ErrorMsgCorrectAnswerIsInvalid     = "correct answer is not one of the possible answers"
+	ErrorMsgQuestionAlreadyAnswered    = "question has already been answered"
+	ErrorMsgQuestionIsNotInGame        = "question doesn't belong to this game"
+	ErrorMsgGameIsFinished             = "game is finished"
+	ErrorMsgUserIsNotInGame            = "user is not a player of this game"
+	ErrorMsgChoiceIsNotValid           = "choice is not valid"
+	ErrorMsgInvalidToken               = "token is invalid or expired"
+	ErrorMsgOTPIsInvalid               = "code is invalid or expired"
+	ErrorMsgPhoneNumberIsNotVerified   = "phone number is not verified"
+	ErrorMsgTooManyRequests            = "too many requests, try again later"
+	ErrorMsgOldPasswordIsNotCorrect    = "old password is not correct"
+	ErrorMsgNewPasswordIsTheSame       = "new password is the same as the old password"
+	ErrorMsgInvalidCredentials         = "phone number or password is not correct"
+	ErrorMsgAvatarIsTooLarge           = "avatar is too large"
+	ErrorMsgAvatarTypeIsNotValid       = "avatar must be a jpeg or png image"
+	ErrorMsgRoleIsNotValid             = "role is not valid"
+	ErrorMsgSortFieldIsNotValid        = "sort field is not valid"
+	ErrorMsgUserIsBanned               = "user is banned"
+	ErrorMsgPermissionTitleIsNotUnique = "permission title is not unique"
+	ErrorMsgPermissionTitleIsNotValid  = "permission title must be lowercase words separated by dashes"
+	ErrorMsgActorTypeIsNotValid        = "actor type is not valid"
+	ErrorMsgAccessControlAlreadyExists = "permission is already granted to this actor"
+	ErrorMsgLastPermissionHolder       = "permission can't be revoked from its last holder"
 )
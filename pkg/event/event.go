@@ -0,0 +1,53 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"gameAppProject/entity"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Event is the envelope of every published event, the payload is decoded by the consumers.
+type Event struct {
+	ID      string           `json:"id"`
+	Type    entity.EventType `json:"type"`
+	Version int              `json:"version"`
+	// AggregateID is the id of the entity that the event is about, e.g. the game id
+	AggregateID string          `json:"aggregate_id"`
+	Timestamp   time.Time       `json:"timestamp"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// Handler processes an event, the event is delivered again if it returns an error.
+type Handler func(ctx context.Context, e Event) error
+
+type Publisher interface {
+	Publish(ctx context.Context, events ...Event) error
+}
+
+// Consumer delivers every event of the given types once to each group,
+// the consumers of the same group share the events. Subscribe blocks until ctx is done.
+type Consumer interface {
+	Subscribe(ctx context.Context, group string, eventTypes []entity.EventType, handler Handler) error
+}
+
+func New(eventType entity.EventType, version int, aggregateID string, payload interface{}) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		ID:          uuid.NewString(),
+		Type:        eventType,
+		Version:     version,
+		AggregateID: aggregateID,
+		Timestamp:   time.Now().UTC(),
+		Payload:     data,
+	}, nil
+}
+
+func (e Event) Decode(payload interface{}) error {
+	return json.Unmarshal(e.Payload, payload)
+}
@@ -0,0 +1,96 @@
+package event
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"sync"
+)
+
+// MemoryBus delivers the events synchronously in the publisher's goroutine, it's meant for tests
+// and single instance setups. The events that fail maxDeliveries times are kept as dead letters.
+type MemoryBus struct {
+	mu            sync.Mutex
+	maxDeliveries int
+	subscribers   map[entity.EventType]map[string]Handler
+	published     []Event
+	deadLetters   []Event
+}
+
+func NewMemoryBus(maxDeliveries int) *MemoryBus {
+	return &MemoryBus{
+		maxDeliveries: max(maxDeliveries, 1),
+		subscribers:   make(map[entity.EventType]map[string]Handler),
+	}
+}
+
+func (b *MemoryBus) Publish(ctx context.Context, events ...Event) error {
+	for _, e := range events {
+		b.mu.Lock()
+		b.published = append(b.published, e)
+		handlers := make([]Handler, 0, len(b.subscribers[e.Type]))
+		for _, h := range b.subscribers[e.Type] {
+			handlers = append(handlers, h)
+		}
+		b.mu.Unlock()
+
+		for _, h := range handlers {
+			b.deliver(ctx, e, h)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe keeps the first handler of a group, like a group with one consumer.
+func (b *MemoryBus) Subscribe(ctx context.Context, group string, eventTypes []entity.EventType, handler Handler) error {
+	registered := make([]entity.EventType, 0, len(eventTypes))
+
+	b.mu.Lock()
+	for _, t := range eventTypes {
+		if b.subscribers[t] == nil {
+			b.subscribers[t] = make(map[string]Handler)
+		}
+
+		if _, ok := b.subscribers[t][group]; !ok {
+			b.subscribers[t][group] = handler
+			registered = append(registered, t)
+		}
+	}
+	b.mu.Unlock()
+
+	<-ctx.Done()
+
+	b.mu.Lock()
+	for _, t := range registered {
+		delete(b.subscribers[t], group)
+	}
+	b.mu.Unlock()
+
+	return nil
+}
+
+func (b *MemoryBus) Published() []Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]Event(nil), b.published...)
+}
+
+func (b *MemoryBus) DeadLetters() []Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]Event(nil), b.deadLetters...)
+}
+
+func (b *MemoryBus) deliver(ctx context.Context, e Event, handler Handler) {
+	for i := 0; i < b.maxDeliveries; i++ {
+		if err := handler(ctx, e); err == nil {
+			return
+		}
+	}
+
+	b.mu.Lock()
+	b.deadLetters = append(b.deadLetters, e)
+	b.mu.Unlock()
+}
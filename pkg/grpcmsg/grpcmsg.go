@@ -0,0 +1,70 @@
+package grpcmsg
+
+import (
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error converts the error of a service to a grpc status error.
+func Error(err error) error {
+	switch err.(type) {
+	case richerror.RichError:
+		re := err.(richerror.RichError)
+		msg := re.Message()
+
+		code := mapKindToGRPCCode(re.Kind())
+
+		// we should not expose unexpected error messages
+		if code == codes.Internal {
+			msg = errmsg.ErrorMsgSomethingWentWrong
+		}
+
+		return status.Error(code, msg)
+	default:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+}
+
+// Kind converts the grpc status of an error, returned by a client, back to the kind of rich errors.
+func Kind(err error) richerror.Kind {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return richerror.KindInvalid
+	case codes.NotFound:
+		return richerror.KindNotFound
+	case codes.PermissionDenied:
+		return richerror.KindForbidden
+	case codes.Unauthenticated:
+		return richerror.KindUnauthorized
+	case codes.ResourceExhausted:
+		return richerror.KindTooManyRequests
+	default:
+		return richerror.KindUnexpected
+	}
+}
+
+// Message returns the message of a grpc status error.
+func Message(err error) string {
+	return status.Convert(err).Message()
+}
+
+func mapKindToGRPCCode(kind richerror.Kind) codes.Code {
+	switch kind {
+	case richerror.KindInvalid:
+		return codes.InvalidArgument
+	case richerror.KindNotFound:
+		return codes.NotFound
+	case richerror.KindForbidden:
+		return codes.PermissionDenied
+	case richerror.KindUnexpected:
+		return codes.Internal
+	case richerror.KindUnauthorized:
+		return codes.Unauthenticated
+	case richerror.KindTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.InvalidArgument
+	}
+}
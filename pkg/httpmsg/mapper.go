@@ -35,6 +35,10 @@ func mapKindToHTTPStatusCode(kind richerror.Kind) int {
 		return http.StatusForbidden
 	case richerror.KindUnexpected:
 		return http.StatusInternalServerError
+	case richerror.KindUnauthorized:
+		return http.StatusUnauthorized
+	case richerror.KindTooManyRequests:
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusBadRequest
 	}
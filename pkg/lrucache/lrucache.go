@@ -0,0 +1,88 @@
+package lrucache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is a fixed size LRU cache, the items expire ttl after they're set. It's safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	items map[K]*list.Element
+	order *list.List
+}
+
+type item[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+func New[K comparable, V any](size int, ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		size:  size,
+		ttl:   ttl,
+		items: make(map[K]*list.Element, size),
+		order: list.New(),
+	}
+}
+
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+
+	e, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+
+	it := e.Value.(*item[K, V])
+	if time.Now().After(it.expiresAt) {
+		c.order.Remove(e)
+		delete(c.items, key)
+
+		return zero, false
+	}
+
+	c.order.MoveToFront(e)
+
+	return it.value, true
+}
+
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.ttl)
+
+	if e, ok := c.items[key]; ok {
+		it := e.Value.(*item[K, V])
+		it.value = value
+		it.expiresAt = expiresAt
+		c.order.MoveToFront(e)
+
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&item[K, V]{key: key, value: value, expiresAt: expiresAt})
+
+	// evict the least recently used item
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*item[K, V]).key)
+	}
+}
+
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[K]*list.Element, c.size)
+	c.order.Init()
+}
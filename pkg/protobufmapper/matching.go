@@ -0,0 +1,140 @@
+package protobufmapper
+
+import (
+	"gameAppProject/contract/goproto/matching"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"time"
+)
+
+func MapAddToWaitingListRequestToProtobuf(req param.AddToWaitingListRequest) *matching.AddToWaitingListRequest {
+	return &matching.AddToWaitingListRequest{UserId: uint64(req.UserID), Category: string(req.Category)}
+}
+
+func MapAddToWaitingListRequestFromProtobuf(req *matching.AddToWaitingListRequest) param.AddToWaitingListRequest {
+	return param.AddToWaitingListRequest{UserID: uint(req.GetUserId()), Category: entity.Category(req.GetCategory())}
+}
+
+func MapAddToWaitingListResponseToProtobuf(res param.AddToWaitingListResponse) *matching.AddToWaitingListResponse {
+	return &matching.AddToWaitingListResponse{TimeoutInNanoseconds: int64(res.Timeout)}
+}
+
+func MapAddToWaitingListResponseFromProtobuf(res *matching.AddToWaitingListResponse) param.AddToWaitingListResponse {
+	return param.AddToWaitingListResponse{Timeout: time.Duration(res.GetTimeoutInNanoseconds())}
+}
+
+func MapLeaveWaitingListRequestToProtobuf(req param.LeaveWaitingListRequest) *matching.LeaveWaitingListRequest {
+	return &matching.LeaveWaitingListRequest{UserId: uint64(req.UserID)}
+}
+
+func MapLeaveWaitingListRequestFromProtobuf(req *matching.LeaveWaitingListRequest) param.LeaveWaitingListRequest {
+	return param.LeaveWaitingListRequest{UserID: uint(req.GetUserId())}
+}
+
+func MapGetWaitingStatusRequestToProtobuf(req param.GetWaitingStatusRequest) *matching.GetWaitingStatusRequest {
+	return &matching.GetWaitingStatusRequest{UserId: uint64(req.UserID)}
+}
+
+func MapGetWaitingStatusRequestFromProtobuf(req *matching.GetWaitingStatusRequest) param.GetWaitingStatusRequest {
+	return param.GetWaitingStatusRequest{UserID: uint(req.GetUserId())}
+}
+
+func MapGetWaitingStatusResponseToProtobuf(res param.GetWaitingStatusResponse) *matching.GetWaitingStatusResponse {
+	return &matching.GetWaitingStatusResponse{
+		Category:                      string(res.Category),
+		WaitingTimeInNanoseconds:      int64(res.WaitingTime),
+		RemainingTimeoutInNanoseconds: int64(res.RemainingTimeout),
+		Position:                      int64(res.Position),
+		QueueSize:                     int64(res.QueueSize),
+	}
+}
+
+func MapGetWaitingStatusResponseFromProtobuf(res *matching.GetWaitingStatusResponse) param.GetWaitingStatusResponse {
+	return param.GetWaitingStatusResponse{
+		Category:         entity.Category(res.GetCategory()),
+		WaitingTime:      time.Duration(res.GetWaitingTimeInNanoseconds()),
+		RemainingTimeout: time.Duration(res.GetRemainingTimeoutInNanoseconds()),
+		Position:         int(res.GetPosition()),
+		QueueSize:        int(res.GetQueueSize()),
+	}
+}
+
+func MapClaimMatchedUsersRequestToProtobuf(req param.ClaimMatchedUsersRequest) *matching.ClaimMatchedUsersRequest {
+	return &matching.ClaimMatchedUsersRequest{Category: string(req.Category), UserIds: mapUserIDsToProtobuf(req.UserIDs)}
+}
+
+func MapClaimMatchedUsersRequestFromProtobuf(req *matching.ClaimMatchedUsersRequest) param.ClaimMatchedUsersRequest {
+	return param.ClaimMatchedUsersRequest{
+		Category: entity.Category(req.GetCategory()),
+		UserIDs:  mapUserIDsFromProtobuf(req.GetUserIds()),
+	}
+}
+
+func MapClaimMatchedUsersResponseToProtobuf(res param.ClaimMatchedUsersResponse) *matching.ClaimMatchedUsersResponse {
+	return &matching.ClaimMatchedUsersResponse{Claimed: res.Claimed, Users: mapWaitingMembersToProtobuf(res.Users)}
+}
+
+// MapClaimMatchedUsersResponseFromProtobuf takes the category of the users from the request, it's the same for all of them.
+func MapClaimMatchedUsersResponseFromProtobuf(res *matching.ClaimMatchedUsersResponse,
+	category entity.Category) param.ClaimMatchedUsersResponse {
+	return param.ClaimMatchedUsersResponse{
+		Claimed: res.GetClaimed(),
+		Users:   mapWaitingMembersFromProtobuf(res.GetUsers(), category),
+	}
+}
+
+func MapRestoreMatchedUsersRequestToProtobuf(req param.RestoreMatchedUsersRequest) *matching.RestoreMatchedUsersRequest {
+	return &matching.RestoreMatchedUsersRequest{
+		Category: string(req.Category),
+		Users:    mapWaitingMembersToProtobuf(req.Users),
+	}
+}
+
+func MapRestoreMatchedUsersRequestFromProtobuf(req *matching.RestoreMatchedUsersRequest) param.RestoreMatchedUsersRequest {
+	category := entity.Category(req.GetCategory())
+
+	return param.RestoreMatchedUsersRequest{
+		Category: category,
+		Users:    mapWaitingMembersFromProtobuf(req.GetUsers(), category),
+	}
+}
+
+func mapWaitingMembersToProtobuf(members []entity.WaitingMember) []*matching.MatchedUser {
+	users := make([]*matching.MatchedUser, 0, len(members))
+	for _, m := range members {
+		users = append(users, &matching.MatchedUser{UserId: uint64(m.UserID), Timestamp: m.Timestamp})
+	}
+
+	return users
+}
+
+func mapWaitingMembersFromProtobuf(users []*matching.MatchedUser, category entity.Category) []entity.WaitingMember {
+	members := make([]entity.WaitingMember, 0, len(users))
+	for _, u := range users {
+		members = append(members, entity.WaitingMember{
+			UserID:    uint(u.GetUserId()),
+			Timestamp: u.GetTimestamp(),
+			Category:  category,
+		})
+	}
+
+	return members
+}
+
+func mapUserIDsToProtobuf(userIDs []uint) []uint64 {
+	ids := make([]uint64, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, uint64(userID))
+	}
+
+	return ids
+}
+
+func mapUserIDsFromProtobuf(userIDs []uint64) []uint {
+	ids := make([]uint, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, uint(userID))
+	}
+
+	return ids
+}
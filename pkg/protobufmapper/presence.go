@@ -0,0 +1,50 @@
+package protobufmapper
+
+import (
+	"gameAppProject/contract/goproto/presence"
+	"gameAppProject/param"
+)
+
+func MapUpsertPresenceRequestToProtobuf(req param.UpsertPresenceRequest) *presence.UpsertPresenceRequest {
+	return &presence.UpsertPresenceRequest{UserId: uint64(req.UserID), Timestamp: req.Timestamp}
+}
+
+func MapUpsertPresenceRequestFromProtobuf(req *presence.UpsertPresenceRequest) param.UpsertPresenceRequest {
+	return param.UpsertPresenceRequest{UserID: uint(req.GetUserId()), Timestamp: req.GetTimestamp()}
+}
+
+func MapGetPresenceRequestToProtobuf(req param.GetPresenceRequest) *presence.GetPresenceRequest {
+	userIDs := make([]uint64, 0, len(req.UserIDs))
+	for _, userID := range req.UserIDs {
+		userIDs = append(userIDs, uint64(userID))
+	}
+
+	return &presence.GetPresenceRequest{UserIds: userIDs}
+}
+
+func MapGetPresenceRequestFromProtobuf(req *presence.GetPresenceRequest) param.GetPresenceRequest {
+	userIDs := make([]uint, 0, len(req.GetUserIds()))
+	for _, userID := range req.GetUserIds() {
+		userIDs = append(userIDs, uint(userID))
+	}
+
+	return param.GetPresenceRequest{UserIDs: userIDs}
+}
+
+func MapGetPresenceResponseToProtobuf(res param.GetPresenceResponse) *presence.GetPresenceResponse {
+	items := make([]*presence.GetPresenceItem, 0, len(res.Items))
+	for _, item := range res.Items {
+		items = append(items, &presence.GetPresenceItem{UserId: uint64(item.UserID), Timestamp: item.Timestamp})
+	}
+
+	return &presence.GetPresenceResponse{Items: items}
+}
+
+func MapGetPresenceResponseFromProtobuf(res *presence.GetPresenceResponse) param.GetPresenceResponse {
+	items := make([]param.GetPresenceItem, 0, len(res.GetItems()))
+	for _, item := range res.GetItems() {
+		items = append(items, param.GetPresenceItem{UserID: uint(item.GetUserId()), Timestamp: item.GetTimestamp()})
+	}
+
+	return param.GetPresenceResponse{Items: items}
+}
@@ -7,6 +7,8 @@ const (
 	KindForbidden
 	KindNotFound
 	KindUnexpected
+	KindUnauthorized
+	KindTooManyRequests
 )
 
 type Op string
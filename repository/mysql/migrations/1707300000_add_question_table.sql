@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE `questions` (
+                             `id` INT PRIMARY KEY AUTO_INCREMENT,
+                             `text` TEXT NOT NULL,
+                             `correct_answer` TINYINT NOT NULL,
+                             `difficulty` TINYINT NOT NULL,
+                             `category` VARCHAR(191) NOT NULL,
+                             `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                             INDEX (`category`)
+);
+
+-- +migrate Down
+DROP TABLE `questions`;
@@ -0,0 +1,31 @@
+-- +migrate Up
+CREATE TABLE `games` (
+                         `id` INT PRIMARY KEY AUTO_INCREMENT,
+                         `category` VARCHAR(191) NOT NULL,
+                         `start_time` TIMESTAMP NOT NULL,
+                         `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE `players` (
+                           `id` INT PRIMARY KEY AUTO_INCREMENT,
+                           `user_id` INT NOT NULL,
+                           `game_id` INT NOT NULL,
+                           `score` INT NOT NULL DEFAULT 0,
+                           `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                           FOREIGN KEY (`user_id`) REFERENCES `users`(`id`),
+                           FOREIGN KEY (`game_id`) REFERENCES `games`(`id`),
+                           UNIQUE (`game_id`, `user_id`)
+);
+
+CREATE TABLE `game_questions` (
+                                  `game_id` INT NOT NULL,
+                                  `question_id` INT NOT NULL,
+                                  PRIMARY KEY (`game_id`, `question_id`),
+                                  FOREIGN KEY (`game_id`) REFERENCES `games`(`id`),
+                                  FOREIGN KEY (`question_id`) REFERENCES `questions`(`id`)
+);
+
+-- +migrate Down
+DROP TABLE `game_questions`;
+DROP TABLE `players`;
+DROP TABLE `games`;
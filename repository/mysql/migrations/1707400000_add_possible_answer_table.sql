@@ -0,0 +1,16 @@
+-- +migrate Up
+ALTER TABLE `questions` ADD COLUMN `deleted_at` TIMESTAMP NULL DEFAULT NULL;
+
+CREATE TABLE `possible_answers` (
+                                    `id` INT PRIMARY KEY AUTO_INCREMENT,
+                                    `question_id` INT NOT NULL,
+                                    `text` VARCHAR(191) NOT NULL,
+                                    `choice` TINYINT NOT NULL,
+                                    `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                    FOREIGN KEY (`question_id`) REFERENCES `questions`(`id`),
+                                    UNIQUE (`question_id`, `choice`)
+);
+
+-- +migrate Down
+DROP TABLE `possible_answers`;
+ALTER TABLE `questions` DROP COLUMN `deleted_at`;
@@ -0,0 +1,14 @@
+-- +migrate Up
+INSERT INTO `permissions` (`id`, `title`) VALUES(3, 'question-create');
+INSERT INTO `permissions` (`id`, `title`) VALUES(4, 'question-update');
+INSERT INTO `permissions` (`id`, `title`) VALUES(5, 'question-delete');
+INSERT INTO `permissions` (`id`, `title`) VALUES(6, 'question-list');
+
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 3);
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 4);
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 5);
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 6);
+
+-- +migrate Down
+DELETE FROM `access_controls` WHERE permission_id in (3,4,5,6);
+DELETE FROM `permissions` WHERE id in (3,4,5,6);
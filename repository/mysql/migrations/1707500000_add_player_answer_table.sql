@@ -0,0 +1,20 @@
+-- +migrate Up
+ALTER TABLE `games` ADD COLUMN `winner_id` INT NULL DEFAULT NULL;
+ALTER TABLE `games` ADD COLUMN `end_time` TIMESTAMP NULL DEFAULT NULL;
+
+CREATE TABLE `player_answers` (
+                                  `id` INT PRIMARY KEY AUTO_INCREMENT,
+                                  `player_id` INT NOT NULL,
+                                  `question_id` INT NOT NULL,
+                                  `choice` TINYINT NOT NULL,
+                                  `is_correct` BOOLEAN NOT NULL,
+                                  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                  FOREIGN KEY (`player_id`) REFERENCES `players`(`id`),
+                                  FOREIGN KEY (`question_id`) REFERENCES `questions`(`id`),
+                                  UNIQUE (`player_id`, `question_id`)
+);
+
+-- +migrate Down
+DROP TABLE `player_answers`;
+ALTER TABLE `games` DROP COLUMN `end_time`;
+ALTER TABLE `games` DROP COLUMN `winner_id`;
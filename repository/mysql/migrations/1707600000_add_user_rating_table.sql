@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE `user_ratings` (
+                                `user_id` INT NOT NULL,
+                                `category` VARCHAR(191) NOT NULL,
+                                `rating` INT NOT NULL,
+                                `games_played` INT NOT NULL DEFAULT 0,
+                                `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+                                PRIMARY KEY (`user_id`, `category`),
+                                FOREIGN KEY (`user_id`) REFERENCES `users`(`id`)
+);
+
+-- +migrate Down
+DROP TABLE `user_ratings`;
@@ -0,0 +1,8 @@
+-- +migrate Up
+INSERT INTO `permissions` (`id`, `title`) VALUES(7, 'user-force-logout');
+
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 7);
+
+-- +migrate Down
+DELETE FROM `access_controls` WHERE permission_id = 7;
+DELETE FROM `permissions` WHERE id = 7;
@@ -0,0 +1,8 @@
+-- +migrate Up
+ALTER TABLE `users` ADD COLUMN `verified_at` TIMESTAMP NULL DEFAULT NULL;
+
+-- users that are registered before the verification are trusted
+UPDATE `users` SET `verified_at` = `created_at`;
+
+-- +migrate Down
+ALTER TABLE `users` DROP COLUMN `verified_at`;
@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE `audit_logs` (
+    `id` INT PRIMARY KEY AUTO_INCREMENT,
+    `action` VARCHAR(191) NOT NULL,
+    `phone_number` VARCHAR(191) NOT NULL DEFAULT '',
+    `ip` VARCHAR(191) NOT NULL DEFAULT '',
+    `description` TEXT NOT NULL,
+    `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX `idx_audit_logs_action_created_at` (`action`, `created_at`)
+);
+
+-- +migrate Down
+DROP TABLE `audit_logs`;
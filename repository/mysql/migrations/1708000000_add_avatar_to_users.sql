@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE `users` ADD COLUMN `avatar` VARCHAR(191) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE `users` DROP COLUMN `avatar`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE `users` ADD COLUMN `banned_at` TIMESTAMP NULL DEFAULT NULL;
+ALTER TABLE `users` ADD COLUMN `deleted_at` TIMESTAMP NULL DEFAULT NULL;
+
+-- +migrate Down
+ALTER TABLE `users` DROP COLUMN `deleted_at`;
+ALTER TABLE `users` DROP COLUMN `banned_at`;
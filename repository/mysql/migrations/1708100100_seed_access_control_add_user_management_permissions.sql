@@ -0,0 +1,10 @@
+-- +migrate Up
+INSERT INTO `permissions` (`id`, `title`) VALUES(8, 'user-ban');
+INSERT INTO `permissions` (`id`, `title`) VALUES(9, 'user-role-update');
+
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 8);
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 9);
+
+-- +migrate Down
+DELETE FROM `access_controls` WHERE permission_id in (8,9);
+DELETE FROM `permissions` WHERE id in (8,9);
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE `access_controls` ADD UNIQUE INDEX `access_controls_actor_permission_unique` (`actor_type`, `actor_id`, `permission_id`);
+
+-- +migrate Down
+ALTER TABLE `access_controls` DROP INDEX `access_controls_actor_permission_unique`;
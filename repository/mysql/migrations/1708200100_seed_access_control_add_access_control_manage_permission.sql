@@ -0,0 +1,9 @@
+-- +migrate Up
+INSERT INTO `permissions` (`id`, `title`) VALUES(10, 'access-control-manage');
+
+-- super admins can grant it to specific users and revoke it from the admin role
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 10);
+
+-- +migrate Down
+DELETE FROM `access_controls` WHERE permission_id = 10;
+DELETE FROM `permissions` WHERE id = 10;
@@ -0,0 +1,18 @@
+-- +migrate Up
+-- the events are written in the transaction of the change and published later by the outbox relay
+CREATE TABLE `outbox_events` (
+                                 `id` BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                 `event_id` VARCHAR(36) NOT NULL UNIQUE,
+                                 `event_type` VARCHAR(191) NOT NULL,
+                                 `aggregate_id` VARCHAR(191) NOT NULL,
+                                 `payload` JSON NOT NULL,
+                                 `attempts` INT NOT NULL DEFAULT 0,
+                                 `last_error` TEXT,
+                                 `next_attempt_at` TIMESTAMP NULL,
+                                 `published_at` TIMESTAMP NULL,
+                                 `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                 INDEX `outbox_events_published_at_id` (`published_at`, `id`)
+);
+
+-- +migrate Down
+DROP TABLE outbox_events;
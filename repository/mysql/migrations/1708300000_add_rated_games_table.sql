@@ -0,0 +1,10 @@
+-- +migrate Up
+-- the games whose ratings are applied, the game finished event may be delivered more than once
+CREATE TABLE `rated_games` (
+                               `game_id` INT PRIMARY KEY,
+                               `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               FOREIGN KEY (`game_id`) REFERENCES `games`(`id`)
+);
+
+-- +migrate Down
+DROP TABLE `rated_games`;
@@ -0,0 +1,22 @@
+-- +migrate Up
+-- append the new role, the order of the enum values must not change
+ALTER TABLE `users` MODIFY COLUMN `role` ENUM('user', 'admin', 'super_admin') NOT NULL;
+
+INSERT INTO `permissions` (`id`, `title`) VALUES(11, 'admin-role-manage');
+
+-- super admins have all permissions of the admins
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`)
+SELECT 'role', 3, `permission_id` FROM `access_controls` WHERE `actor_type` = 'role' AND `actor_id` = 2;
+
+-- only super admins change the roles, the first super admin is promoted by hand:
+-- UPDATE `users` SET `role` = 'super_admin' WHERE `id` = ?;
+DELETE FROM `access_controls` WHERE `actor_type` = 'role' AND `actor_id` = 2 AND `permission_id` = 9;
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 3, 11);
+
+-- +migrate Down
+INSERT INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 9);
+DELETE FROM `access_controls` WHERE permission_id = 11;
+DELETE FROM `access_controls` WHERE `actor_type` = 'role' AND `actor_id` = 3;
+DELETE FROM `permissions` WHERE id = 11;
+UPDATE `users` SET `role` = 'admin' WHERE `role` = 'super_admin';
+ALTER TABLE `users` MODIFY COLUMN `role` ENUM('user', 'admin') NOT NULL;
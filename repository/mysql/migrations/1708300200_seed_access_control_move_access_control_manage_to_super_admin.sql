@@ -0,0 +1,7 @@
+-- +migrate Up
+-- the super admins already have it by 1708300100, the admins don't manage the ACLs anymore
+INSERT IGNORE INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 3, 10);
+DELETE FROM `access_controls` WHERE `actor_type` = 'role' AND `actor_id` = 2 AND `permission_id` = 10;
+
+-- +migrate Down
+INSERT IGNORE INTO `access_controls` (`actor_type`, `actor_id`, `permission_id`) VALUES('role', 2, 10);
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- an event that has failed too many times is marked as dead and is not retried anymore
+ALTER TABLE `outbox_events` ADD COLUMN `dead_at` TIMESTAMP NULL DEFAULT NULL;
+ALTER TABLE `outbox_events` ADD INDEX `outbox_events_aggregate_id_id` (`aggregate_id`, `id`);
+
+-- +migrate Down
+ALTER TABLE `outbox_events` DROP INDEX `outbox_events_aggregate_id_id`;
+ALTER TABLE `outbox_events` DROP COLUMN `dead_at`;
@@ -1,113 +1,41 @@
 package mysqlaccesscontrol
 
 import (
+	"context"
 	"gameAppProject/entity"
 	"gameAppProject/pkg/errmsg"
 	"gameAppProject/pkg/richerror"
-	"gameAppProject/pkg/slice"
 	"gameAppProject/repository/mysql"
-	"strings"
 	"time"
 )
 
-func (d *DB) GetUserPermissionTitles(userID uint, role entity.Role) ([]entity.PermissionTitle, error) {
+// GetUserPermissionTitles merges the ACLs of the role and the user in one query.
+func (d *DB) GetUserPermissionTitles(ctx context.Context, userID uint, role entity.Role) ([]entity.PermissionTitle, error) {
 	const op = "mysql.GetUserPermissionTitles"
 
-	roleACL := make([]entity.AccessControl, 0)
-
-	rows, err := d.conn.Conn().Query(`select * from access_controls where actor_type = ? and actor_id = ?`, entity.RoleActorType, role)
+	rows, err := d.conn.Conn().QueryContext(ctx,
+		`select distinct p.title from permissions p join access_controls ac on ac.permission_id = p.id
+		where (ac.actor_type = ? and ac.actor_id = ?) or (ac.actor_type = ? and ac.actor_id = ?)`,
+		entity.RoleActorType, role, entity.UserActorType, userID)
 	if err != nil {
 		return nil, richerror.New(op).WithErr(err).
 			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
 	}
-
 	defer rows.Close()
 
-	for rows.Next() {
-		acl, err := scanAccessControl(rows)
-		if err != nil {
-			return nil, richerror.New(op).WithErr(err).
-				WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
-		}
-
-		roleACL = append(roleACL, acl)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, richerror.New(op).WithErr(err).
-			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
-	}
-
-	userACL := make([]entity.AccessControl, 0)
-
-	userRows, err := d.conn.Conn().Query(`select * from access_controls where actor_type = ? and actor_id = ?`,
-		entity.UserActorType, userID)
-	if err != nil {
-		return nil, richerror.New(op).WithErr(err).
-			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
-	}
-
-	defer userRows.Close()
-
-	for userRows.Next() {
-		acl, err := scanAccessControl(userRows)
-		if err != nil {
-			return nil, richerror.New(op).WithErr(err).
-				WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
-		}
-
-		userACL = append(userACL, acl)
-	}
-
-	if err := userRows.Err(); err != nil {
-		return nil, richerror.New(op).WithErr(err).
-			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
-	}
-
-	// merge ACLs by permission id
-	permissionIDs := make([]uint, 0)
-	for _, r := range roleACL {
-		if !slice.DoesExist(permissionIDs, r.PermissionID) {
-			permissionIDs = append(permissionIDs, r.PermissionID)
-		}
-	}
-
-	if len(permissionIDs) == 0 {
-		return nil, nil
-	}
-
-	// select * from permissions where id in (?,?,?,?...)
-	args := make([]any, len(permissionIDs))
-
-	for i, id := range permissionIDs {
-		args[i] = id
-	}
-
-	// warning: this query works if we have one or more permission id
-	query := "select * from permissions where id in (?" +
-		strings.Repeat(",?", len(permissionIDs)-1) +
-		")"
-
-	pRows, err := d.conn.Conn().Query(query, args...)
-	if err != nil {
-		return nil, richerror.New(op).WithErr(err).
-			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
-	}
-	defer pRows.Close()
-
 	permissionTitles := make([]entity.PermissionTitle, 0)
 
-	for pRows.Next() {
-		permission, err := scanPermission(pRows)
-		if err != nil {
+	for rows.Next() {
+		var title entity.PermissionTitle
+		if err := rows.Scan(&title); err != nil {
 			return nil, richerror.New(op).WithErr(err).
-				WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
 		}
 
-		permissionTitles = append(permissionTitles, permission.Title)
+		permissionTitles = append(permissionTitles, title)
 	}
 
-	if err := pRows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, richerror.New(op).WithErr(err).
 			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
 	}
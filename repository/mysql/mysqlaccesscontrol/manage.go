@@ -0,0 +1,343 @@
+package mysqlaccesscontrol
+
+import (
+	"context"
+	"database/sql"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/event"
+	"gameAppProject/pkg/richerror"
+	"gameAppProject/repository/mysql"
+	"strconv"
+	"strings"
+)
+
+func (d *DB) IsPermissionTitleUnique(title string) (bool, error) {
+	const op = "mysqlaccesscontrol.IsPermissionTitleUnique"
+
+	row := d.conn.Conn().QueryRow(`select * from permissions where title = ?`, title)
+
+	_, err := scanPermission(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return true, nil
+		}
+
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	return false, nil
+}
+
+func (d *DB) CreatePermission(ctx context.Context, title entity.PermissionTitle) (entity.Permission, error) {
+	const op = "mysqlaccesscontrol.CreatePermission"
+
+	res, err := d.conn.Conn().ExecContext(ctx, `insert into permissions(title) values(?)`, title)
+	if err != nil {
+		return entity.Permission{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	// error is always nil
+	id, _ := res.LastInsertId()
+
+	return entity.Permission{ID: uint(id), Title: title}, nil
+}
+
+func (d *DB) GetPermissionByID(ctx context.Context, permissionID uint) (entity.Permission, error) {
+	const op = "mysqlaccesscontrol.GetPermissionByID"
+
+	row := d.conn.Conn().QueryRowContext(ctx, `select * from permissions where id = ?`, permissionID)
+
+	p, err := scanPermission(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Permission{}, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgNotFound).WithKind(richerror.KindNotFound)
+		}
+
+		return entity.Permission{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	return p, nil
+}
+
+func (d *DB) ListPermissions(ctx context.Context) ([]entity.Permission, error) {
+	const op = "mysqlaccesscontrol.ListPermissions"
+
+	rows, err := d.conn.Conn().QueryContext(ctx, `select * from permissions order by id`)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	permissions := make([]entity.Permission, 0)
+
+	for rows.Next() {
+		p, err := scanPermission(rows)
+		if err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		permissions = append(permissions, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return permissions, nil
+}
+
+func (d *DB) CreateAccessControl(ctx context.Context, acl entity.AccessControl) (entity.AccessControl, error) {
+	const op = "mysqlaccesscontrol.CreateAccessControl"
+
+	tx, err := d.conn.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return entity.AccessControl{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	// rollback is a no-op after a successful commit
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		`insert into access_controls(actor_type, actor_id, permission_id) values(?, ?, ?)`,
+		acl.ActorType, acl.ActorID, acl.PermissionID)
+	if err != nil {
+		return entity.AccessControl{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	// error is always nil
+	id, _ := res.LastInsertId()
+	acl.ID = uint(id)
+
+	if err := insertAccessControlEvent(ctx, tx, entity.AccessControlGrantedEvent, acl); err != nil {
+		return entity.AccessControl{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return entity.AccessControl{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return acl, nil
+}
+
+func (d *DB) GetAccessControlByID(ctx context.Context, aclID uint) (entity.AccessControl, error) {
+	const op = "mysqlaccesscontrol.GetAccessControlByID"
+
+	row := d.conn.Conn().QueryRowContext(ctx, `select * from access_controls where id = ?`, aclID)
+
+	acl, err := scanAccessControl(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.AccessControl{}, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgNotFound).WithKind(richerror.KindNotFound)
+		}
+
+		return entity.AccessControl{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	return acl, nil
+}
+
+func (d *DB) DoesAccessControlExist(ctx context.Context, actorType entity.ActorType, actorID, permissionID uint) (bool, error) {
+	const op = "mysqlaccesscontrol.DoesAccessControlExist"
+
+	row := d.conn.Conn().QueryRowContext(ctx,
+		`select * from access_controls where actor_type = ? and actor_id = ? and permission_id = ?`,
+		actorType, actorID, permissionID)
+
+	_, err := scanAccessControl(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	return true, nil
+}
+
+// DeleteAccessControl doesn't delete the ACL when keepHolder is true and no active user would hold its permission.
+func (d *DB) DeleteAccessControl(ctx context.Context, aclID uint, keepHolder bool) error {
+	const op = "mysqlaccesscontrol.DeleteAccessControl"
+
+	tx, err := d.conn.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	// rollback is a no-op after a successful commit
+	defer tx.Rollback()
+
+	// the row is locked, so a concurrent revoke doesn't save a second event
+	acl, err := scanAccessControl(tx.QueryRowContext(ctx,
+		`select * from access_controls where id = ? for update`, aclID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return richerror.New(op).WithErr(err).WithMessage(errmsg.ErrorMsgNotFound).WithKind(richerror.KindNotFound)
+		}
+
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	if keepHolder {
+		holders, err := countOtherPermissionHolders(ctx, tx, acl)
+		if err != nil {
+			return richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+		}
+
+		if holders == 0 {
+			return richerror.New(op).WithMessage(errmsg.ErrorMsgLastPermissionHolder).
+				WithKind(richerror.KindForbidden).WithMeta(map[string]interface{}{"access_control_id": aclID})
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `delete from access_controls where id = ?`, aclID); err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := insertAccessControlEvent(ctx, tx, entity.AccessControlRevokedEvent, acl); err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// countOtherPermissionHolders counts the active users that hold the permission of the ACL by another ACL,
+// the other ACLs of the permission are locked, so two concurrent revokes can't both see a holder left.
+func countOtherPermissionHolders(ctx context.Context, tx *sql.Tx, acl entity.AccessControl) (int, error) {
+	rows, err := tx.QueryContext(ctx, `select id from access_controls where permission_id = ? for update`,
+		acl.PermissionID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := rows.Close(); err != nil {
+		return 0, err
+	}
+
+	// the enum index of the users role is its entity.Role value
+	var count int
+	err = tx.QueryRowContext(ctx,
+		`select count(distinct u.id) from users u join access_controls ac
+		on ac.permission_id = ? and ac.id != ?
+		and ((ac.actor_type = ? and ac.actor_id = u.id) or (ac.actor_type = ? and ac.actor_id = u.role + 0))
+		where u.deleted_at is null and u.banned_at is null`,
+		acl.PermissionID, acl.ID, entity.UserActorType, entity.RoleActorType).Scan(&count)
+
+	return count, err
+}
+
+// ListAccessControls filters by the actor when actorType isn't empty.
+func (d *DB) ListAccessControls(ctx context.Context, actorType entity.ActorType, actorID uint) ([]entity.AccessControl, error) {
+	const op = "mysqlaccesscontrol.ListAccessControls"
+
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+
+	if actorType != "" {
+		conditions = append(conditions, "actor_type = ?", "actor_id = ?")
+		args = append(args, actorType, actorID)
+	}
+
+	query := "select * from access_controls"
+	if len(conditions) > 0 {
+		query += " where " + strings.Join(conditions, " and ")
+	}
+	query += " order by id"
+
+	rows, err := d.conn.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	acls := make([]entity.AccessControl, 0)
+
+	for rows.Next() {
+		acl, err := scanAccessControl(rows)
+		if err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		acls = append(acls, acl)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return acls, nil
+}
+
+// GetUserPermissions returns the permissions granted to the user directly or through their role.
+func (d *DB) GetUserPermissions(ctx context.Context, userID uint, role entity.Role) ([]entity.Permission, error) {
+	const op = "mysqlaccesscontrol.GetUserPermissions"
+
+	rows, err := d.conn.Conn().QueryContext(ctx,
+		`select distinct p.* from permissions p join access_controls ac on ac.permission_id = p.id
+		where (ac.actor_type = ? and ac.actor_id = ?) or (ac.actor_type = ? and ac.actor_id = ?) order by p.id`,
+		entity.RoleActorType, role, entity.UserActorType, userID)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	permissions := make([]entity.Permission, 0)
+
+	for rows.Next() {
+		p, err := scanPermission(rows)
+		if err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		permissions = append(permissions, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return permissions, nil
+}
+
+func insertAccessControlEvent(ctx context.Context, tx *sql.Tx, eventType entity.EventType, acl entity.AccessControl) error {
+	e, err := event.New(eventType, 1, strconv.FormatUint(uint64(acl.ID), 10), entity.AccessControlChanged{
+		ID:           acl.ID,
+		ActorType:    acl.ActorType,
+		ActorID:      acl.ActorID,
+		PermissionID: acl.PermissionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return mysql.InsertOutboxEvents(ctx, tx, e)
+}
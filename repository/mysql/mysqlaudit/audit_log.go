@@ -0,0 +1,26 @@
+package mysqlaudit
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+)
+
+func (d *DB) CreateAuditLog(ctx context.Context, log entity.AuditLog) (entity.AuditLog, error) {
+	const op = "mysqlaudit.CreateAuditLog"
+
+	res, err := d.conn.Conn().ExecContext(ctx,
+		`insert into audit_logs(action, phone_number, ip, description) values(?, ?, ?, ?)`,
+		log.Action, log.PhoneNumber, log.IP, log.Description)
+	if err != nil {
+		return entity.AuditLog{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	// error is always nil
+	id, _ := res.LastInsertId()
+	log.ID = uint(id)
+
+	return log, nil
+}
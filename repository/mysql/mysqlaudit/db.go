@@ -0,0 +1,13 @@
+package mysqlaudit
+
+import "gameAppProject/repository/mysql"
+
+type DB struct {
+	conn *mysql.MySQLDB
+}
+
+func New(conn *mysql.MySQLDB) *DB {
+	return &DB{
+		conn: conn,
+	}
+}
@@ -0,0 +1,228 @@
+package mysqlgame
+
+import (
+	"context"
+	"database/sql"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/event"
+	"gameAppProject/pkg/richerror"
+	"gameAppProject/repository/mysql"
+	"strconv"
+	"time"
+)
+
+// CreateGame stores the game, its players, its questions and the game started event in one transaction
+// and fills the generated game and player ids.
+func (d *DB) CreateGame(ctx context.Context, game entity.Game, userIDs []uint) (entity.Game, error) {
+	const op = "mysqlgame.CreateGame"
+
+	tx, err := d.conn.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return entity.Game{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	// rollback is a no-op after a successful commit
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `insert into games(category, start_time) values(?, ?)`,
+		game.Category, game.StartTime)
+	if err != nil {
+		return entity.Game{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	// error is always nil
+	gameID, _ := res.LastInsertId()
+	game.ID = uint(gameID)
+
+	game.PlayerIDs = make([]uint, 0, len(userIDs))
+	for _, userID := range userIDs {
+		res, err := tx.ExecContext(ctx, `insert into players(user_id, game_id) values(?, ?)`, userID, game.ID)
+		if err != nil {
+			return entity.Game{}, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+		}
+
+		playerID, _ := res.LastInsertId()
+		game.PlayerIDs = append(game.PlayerIDs, uint(playerID))
+	}
+
+	for _, questionID := range game.QuestionIDs {
+		if _, err := tx.ExecContext(ctx, `insert into game_questions(game_id, question_id) values(?, ?)`,
+			game.ID, questionID); err != nil {
+			return entity.Game{}, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+		}
+	}
+
+	e, err := event.New(entity.GameStartedEvent, 1, strconv.FormatUint(uint64(game.ID), 10), entity.GameStarted{
+		GameID:   game.ID,
+		Category: game.Category,
+		UserIDs:  userIDs,
+	})
+	if err != nil {
+		return entity.Game{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := mysql.InsertOutboxEvents(ctx, tx, e); err != nil {
+		return entity.Game{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return entity.Game{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return game, nil
+}
+
+func (d *DB) GetGameByID(ctx context.Context, gameID uint) (entity.Game, error) {
+	const op = "mysqlgame.GetGameByID"
+
+	row := d.conn.Conn().QueryRowContext(ctx,
+		`select id, category, winner_id, start_time, end_time from games where id = ?`, gameID)
+
+	game, err := scanGame(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Game{}, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgNotFound).WithKind(richerror.KindNotFound)
+		}
+
+		return entity.Game{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	game.QuestionIDs, err = d.queryIDs(ctx, `select question_id from game_questions where game_id = ?`, gameID)
+	if err != nil {
+		return entity.Game{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	game.PlayerIDs, err = d.queryIDs(ctx, `select id from players where game_id = ? order by id`, gameID)
+	if err != nil {
+		return entity.Game{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return game, nil
+}
+
+// FinishGame sets the winner and the end time of a running game and saves the game finished event,
+// it returns false if the game has already been finished, then no event is saved.
+func (d *DB) FinishGame(ctx context.Context, gameID uint, winnerID uint) (bool, error) {
+	const op = "mysqlgame.FinishGame"
+
+	tx, err := d.conn.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	// rollback is a no-op after a successful commit
+	defer tx.Rollback()
+
+	winner := sql.NullInt64{Int64: int64(winnerID), Valid: winnerID != 0}
+
+	res, err := tx.ExecContext(ctx,
+		`update games set winner_id = ?, end_time = ? where id = ? and end_time is null`,
+		winner, time.Now(), gameID)
+	if err != nil {
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	// error is always nil
+	affected, _ := res.RowsAffected()
+	if affected != 1 {
+		return false, nil
+	}
+
+	finished, err := gameFinishedEvent(ctx, tx, gameID, winnerID)
+	if err != nil {
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := mysql.InsertOutboxEvents(ctx, tx, finished); err != nil {
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return true, nil
+}
+
+func gameFinishedEvent(ctx context.Context, tx *sql.Tx, gameID, winnerPlayerID uint) (event.Event, error) {
+	payload := entity.GameFinished{GameID: gameID, Scores: make([]entity.PlayerScore, 0)}
+
+	if err := tx.QueryRowContext(ctx, `select category from games where id = ?`, gameID).
+		Scan(&payload.Category); err != nil {
+		return event.Event{}, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `select id, user_id, score from players where game_id = ?`, gameID)
+	if err != nil {
+		return event.Event{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var playerID uint
+		var score entity.PlayerScore
+		if err := rows.Scan(&playerID, &score.UserID, &score.Score); err != nil {
+			return event.Event{}, err
+		}
+
+		if playerID == winnerPlayerID {
+			payload.WinnerUserID = score.UserID
+		}
+
+		payload.Scores = append(payload.Scores, score)
+	}
+
+	if err := rows.Err(); err != nil {
+		return event.Event{}, err
+	}
+
+	return event.New(entity.GameFinishedEvent, 1, strconv.FormatUint(uint64(gameID), 10), payload)
+}
+
+func (d *DB) queryIDs(ctx context.Context, query string, args ...any) ([]uint, error) {
+	rows, err := d.conn.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uint, 0)
+	for rows.Next() {
+		var id uint
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func scanGame(scanner mysql.Scanner) (entity.Game, error) {
+	var game entity.Game
+	var winnerID sql.NullInt64
+	var endTime sql.NullTime
+
+	err := scanner.Scan(&game.ID, &game.Category, &winnerID, &game.StartTime, &endTime)
+
+	game.WinnerID = uint(winnerID.Int64)
+	game.EndTime = endTime.Time
+
+	return game, err
+}
@@ -0,0 +1,137 @@
+package mysqlgame
+
+import (
+	"context"
+	"errors"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"gameAppProject/repository/mysql"
+	mysqldriver "github.com/go-sql-driver/mysql"
+)
+
+const mysqlErrDuplicateEntry = 1062
+
+// GetPlayersByGameID returns the players of a game with their answers.
+func (d *DB) GetPlayersByGameID(ctx context.Context, gameID uint) ([]entity.Player, error) {
+	const op = "mysqlgame.GetPlayersByGameID"
+
+	rows, err := d.conn.Conn().QueryContext(ctx,
+		`select id, user_id, game_id, score from players where game_id = ? order by id`, gameID)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	players := make([]entity.Player, 0)
+	playerIndex := make(map[uint]int)
+
+	for rows.Next() {
+		p, err := scanPlayer(rows)
+		if err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		playerIndex[p.ID] = len(players)
+		players = append(players, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	aRows, err := d.conn.Conn().QueryContext(ctx,
+		`select pa.id, pa.player_id, pa.question_id, pa.choice, pa.is_correct from player_answers pa
+			join players p on p.id = pa.player_id where p.game_id = ? order by pa.id`, gameID)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer aRows.Close()
+
+	for aRows.Next() {
+		a, err := scanPlayerAnswer(aRows)
+		if err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		i := playerIndex[a.PlayerID]
+		players[i].Answers = append(players[i].Answers, a)
+	}
+
+	if err := aRows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return players, nil
+}
+
+// CreatePlayerAnswer stores the answer and adds a point to the player if it is correct,
+// it returns the updated score of the player.
+func (d *DB) CreatePlayerAnswer(ctx context.Context, answer entity.PlayerAnswer) (uint, error) {
+	const op = "mysqlgame.CreatePlayerAnswer"
+
+	tx, err := d.conn.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	// rollback is a no-op after a successful commit
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		`insert into player_answers(player_id, question_id, choice, is_correct) values(?, ?, ?, ?)`,
+		answer.PlayerID, answer.QuestionID, answer.Choice, answer.IsCorrect); err != nil {
+		var mErr *mysqldriver.MySQLError
+		if errors.As(err, &mErr) && mErr.Number == mysqlErrDuplicateEntry {
+			return 0, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgQuestionAlreadyAnswered).WithKind(richerror.KindInvalid)
+		}
+
+		return 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if answer.IsCorrect {
+		if _, err := tx.ExecContext(ctx, `update players set score = score + 1 where id = ?`,
+			answer.PlayerID); err != nil {
+			return 0, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+		}
+	}
+
+	var score uint
+	if err := tx.QueryRowContext(ctx, `select score from players where id = ?`, answer.PlayerID).
+		Scan(&score); err != nil {
+		return 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return score, nil
+}
+
+func scanPlayer(scanner mysql.Scanner) (entity.Player, error) {
+	var p entity.Player
+
+	err := scanner.Scan(&p.ID, &p.UserID, &p.GameID, &p.Score)
+
+	return p, err
+}
+
+func scanPlayerAnswer(scanner mysql.Scanner) (entity.PlayerAnswer, error) {
+	var a entity.PlayerAnswer
+
+	err := scanner.Scan(&a.ID, &a.PlayerID, &a.QuestionID, &a.Choice, &a.IsCorrect)
+
+	return a, err
+}
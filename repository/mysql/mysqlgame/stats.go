@@ -0,0 +1,53 @@
+package mysqlgame
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+)
+
+// GetUserStats returns the stats of the finished games of the user, categories without a game are not in the result.
+func (d *DB) GetUserStats(ctx context.Context, userID uint) ([]entity.CategoryStats, error) {
+	const op = "mysqlgame.GetUserStats"
+
+	rows, err := d.conn.Conn().QueryContext(ctx, `
+		select g.category,
+			count(*),
+			sum(case when g.winner_id = p.id then 1 else 0 end),
+			coalesce(sum(a.answered), 0),
+			coalesce(sum(a.correct), 0)
+		from players p
+		join games g on g.id = p.game_id
+		left join (
+			select player_id, count(*) as answered, sum(is_correct) as correct
+			from player_answers
+			group by player_id
+		) a on a.player_id = p.id
+		where p.user_id = ? and g.end_time is not null
+		group by g.category`, userID)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	stats := make([]entity.CategoryStats, 0)
+
+	for rows.Next() {
+		var s entity.CategoryStats
+		if err := rows.Scan(&s.Category, &s.GamesPlayed, &s.Wins, &s.AnsweredQuestions, &s.CorrectAnswers); err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		stats = append(stats, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,13 @@
+package mysqloutbox
+
+import "gameAppProject/repository/mysql"
+
+type DB struct {
+	conn *mysql.MySQLDB
+}
+
+func New(conn *mysql.MySQLDB) *DB {
+	return &DB{
+		conn: conn,
+	}
+}
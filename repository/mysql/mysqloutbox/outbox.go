@@ -0,0 +1,126 @@
+package mysqloutbox
+
+import (
+	"context"
+	"database/sql"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"gameAppProject/repository/mysql"
+	"strings"
+	"time"
+)
+
+// GetUnpublishedOutboxEvents returns the oldest events that are due at now first, the dead events are skipped.
+// The events after an event that waits for a retry of the same aggregate are not returned, so the order is kept.
+func (d *DB) GetUnpublishedOutboxEvents(ctx context.Context, now time.Time, limit int) ([]entity.OutboxEvent, error) {
+	const op = "mysqloutbox.GetUnpublishedOutboxEvents"
+
+	rows, err := d.conn.Conn().QueryContext(ctx,
+		`select o.id, o.event_id, o.event_type, o.aggregate_id, o.payload, o.attempts, o.next_attempt_at
+		from outbox_events o
+		where o.published_at is null and o.dead_at is null
+		and (o.next_attempt_at is null or o.next_attempt_at <= ?)
+		and not exists (select 1 from outbox_events w
+			where w.aggregate_id = o.aggregate_id and w.id < o.id
+			and w.published_at is null and w.dead_at is null and w.next_attempt_at > ?
+			and substring_index(w.event_type, '.', 1) = substring_index(o.event_type, '.', 1))
+		order by o.id limit ?`, now, now, limit)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	events := make([]entity.OutboxEvent, 0)
+
+	for rows.Next() {
+		e, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return events, nil
+}
+
+func (d *DB) MarkOutboxEventsPublished(ctx context.Context, ids []uint) error {
+	const op = "mysqloutbox.MarkOutboxEventsPublished"
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, len(ids)+1)
+	args = append(args, time.Now())
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	query := "update outbox_events set published_at = ? where id in (?" + strings.Repeat(",?", len(ids)-1) + ")"
+
+	if _, err := d.conn.Conn().ExecContext(ctx, query, args...); err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (d *DB) MarkOutboxEventFailed(ctx context.Context, id uint, lastError string, nextAttemptAt time.Time) error {
+	const op = "mysqloutbox.MarkOutboxEventFailed"
+
+	if _, err := d.conn.Conn().ExecContext(ctx,
+		`update outbox_events set attempts = attempts + 1, last_error = ?, next_attempt_at = ? where id = ?`,
+		lastError, nextAttemptAt, id); err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// MarkOutboxEventDead stops retrying the event, it's kept for an investigation.
+func (d *DB) MarkOutboxEventDead(ctx context.Context, id uint, lastError string) error {
+	const op = "mysqloutbox.MarkOutboxEventDead"
+
+	if _, err := d.conn.Conn().ExecContext(ctx,
+		`update outbox_events set attempts = attempts + 1, last_error = ?, dead_at = ? where id = ?`,
+		lastError, time.Now(), id); err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (d *DB) DeletePublishedOutboxEvents(ctx context.Context, before time.Time) error {
+	const op = "mysqloutbox.DeletePublishedOutboxEvents"
+
+	if _, err := d.conn.Conn().ExecContext(ctx,
+		`delete from outbox_events where published_at is not null and published_at < ?`, before); err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func scanOutboxEvent(scanner mysql.Scanner) (entity.OutboxEvent, error) {
+	var e entity.OutboxEvent
+	var nextAttemptAt sql.NullTime
+
+	err := scanner.Scan(&e.ID, &e.EventID, &e.EventType, &e.AggregateID, &e.Payload, &e.Attempts, &nextAttemptAt)
+
+	e.NextAttemptAt = nextAttemptAt.Time
+
+	return e, err
+}
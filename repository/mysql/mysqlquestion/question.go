@@ -0,0 +1,311 @@
+package mysqlquestion
+
+import (
+	"context"
+	"database/sql"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"gameAppProject/repository/mysql"
+	"strings"
+	"time"
+)
+
+func (d *DB) GetRandomQuestionIDs(ctx context.Context, category entity.Category, count int) ([]uint, error) {
+	const op = "mysqlquestion.GetRandomQuestionIDs"
+
+	// TODO - order by rand() does a full scan on the category, replace it when the question bank grows
+	rows, err := d.conn.Conn().QueryContext(ctx,
+		`select id from questions where category = ? and deleted_at is null order by rand() limit ?`,
+		category, count)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	questionIDs := make([]uint, 0, count)
+
+	for rows.Next() {
+		var id uint
+		if err := rows.Scan(&id); err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		questionIDs = append(questionIDs, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return questionIDs, nil
+}
+
+func (d *DB) CreateQuestion(ctx context.Context, q entity.Question) (entity.Question, error) {
+	const op = "mysqlquestion.CreateQuestion"
+
+	tx, err := d.conn.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	// rollback is a no-op after a successful commit
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		`insert into questions(text, correct_answer, difficulty, category) values(?, ?, ?, ?)`,
+		q.Text, q.CorrectAnswer, q.Difficulty, q.Category)
+	if err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	// error is always nil
+	id, _ := res.LastInsertId()
+	q.ID = uint(id)
+
+	q.PossibleAnswers, err = insertPossibleAnswers(ctx, tx, q.ID, q.PossibleAnswers)
+	if err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return q, nil
+}
+
+func (d *DB) UpdateQuestion(ctx context.Context, q entity.Question) (entity.Question, error) {
+	const op = "mysqlquestion.UpdateQuestion"
+
+	tx, err := d.conn.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer tx.Rollback()
+
+	var id uint
+	err = tx.QueryRowContext(ctx, `select id from questions where id = ? and deleted_at is null for update`, q.ID).
+		Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Question{}, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgNotFound).WithKind(richerror.KindNotFound)
+		}
+
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`update questions set text = ?, correct_answer = ?, difficulty = ?, category = ? where id = ?`,
+		q.Text, q.CorrectAnswer, q.Difficulty, q.Category, q.ID); err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if _, err := tx.ExecContext(ctx, `delete from possible_answers where question_id = ?`, q.ID); err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	q.PossibleAnswers, err = insertPossibleAnswers(ctx, tx, q.ID, q.PossibleAnswers)
+	if err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return q, nil
+}
+
+// DeleteQuestion soft deletes the question, games that already use it still reference the row.
+func (d *DB) DeleteQuestion(ctx context.Context, questionID uint) error {
+	const op = "mysqlquestion.DeleteQuestion"
+
+	res, err := d.conn.Conn().ExecContext(ctx,
+		`update questions set deleted_at = ? where id = ? and deleted_at is null`, time.Now(), questionID)
+	if err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	// error is always nil
+	affected, _ := res.RowsAffected()
+	if affected == 0 {
+		return richerror.New(op).WithMessage(errmsg.ErrorMsgNotFound).WithKind(richerror.KindNotFound)
+	}
+
+	return nil
+}
+
+// GetQuestionByID returns deleted questions too, running games may still use them.
+func (d *DB) GetQuestionByID(ctx context.Context, questionID uint) (entity.Question, error) {
+	const op = "mysqlquestion.GetQuestionByID"
+
+	row := d.conn.Conn().QueryRowContext(ctx,
+		`select id, text, correct_answer, difficulty, category from questions where id = ?`, questionID)
+
+	q, err := scanQuestion(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entity.Question{}, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgNotFound).WithKind(richerror.KindNotFound)
+		}
+
+		return entity.Question{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	answers, err := d.getPossibleAnswers(ctx, []uint{q.ID})
+	if err != nil {
+		return entity.Question{}, richerror.New(op).WithErr(err)
+	}
+
+	q.PossibleAnswers = answers[q.ID]
+
+	return q, nil
+}
+
+// ListQuestions returns one page of questions and the number of all questions that match the filter,
+// an empty category means all categories.
+func (d *DB) ListQuestions(ctx context.Context, category entity.Category, offset, limit uint) ([]entity.Question, uint, error) {
+	const op = "mysqlquestion.ListQuestions"
+
+	where := "deleted_at is null"
+	args := make([]any, 0)
+	if category != "" {
+		where += " and category = ?"
+		args = append(args, category)
+	}
+
+	var total uint
+	if err := d.conn.Conn().QueryRowContext(ctx, "select count(*) from questions where "+where, args...).
+		Scan(&total); err != nil {
+		return nil, 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	rows, err := d.conn.Conn().QueryContext(ctx,
+		"select id, text, correct_answer, difficulty, category from questions where "+where+
+			" order by id limit ? offset ?", append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	questions := make([]entity.Question, 0)
+	questionIDs := make([]uint, 0)
+
+	for rows.Next() {
+		q, err := scanQuestion(rows)
+		if err != nil {
+			return nil, 0, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		questions = append(questions, q)
+		questionIDs = append(questionIDs, q.ID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	answers, err := d.getPossibleAnswers(ctx, questionIDs)
+	if err != nil {
+		return nil, 0, richerror.New(op).WithErr(err)
+	}
+
+	for i := range questions {
+		questions[i].PossibleAnswers = answers[questions[i].ID]
+	}
+
+	return questions, total, nil
+}
+
+func (d *DB) getPossibleAnswers(ctx context.Context, questionIDs []uint) (map[uint][]entity.PossibleAnswer, error) {
+	const op = "mysqlquestion.getPossibleAnswers"
+
+	result := make(map[uint][]entity.PossibleAnswer)
+
+	if len(questionIDs) == 0 {
+		return result, nil
+	}
+
+	args := make([]any, len(questionIDs))
+	for i, id := range questionIDs {
+		args[i] = id
+	}
+
+	// warning: this query works if we have one or more question id
+	query := "select id, question_id, text, choice from possible_answers where question_id in (?" +
+		strings.Repeat(",?", len(questionIDs)-1) +
+		") order by choice"
+
+	rows, err := d.conn.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var questionID uint
+		var a entity.PossibleAnswer
+
+		if err := rows.Scan(&a.ID, &questionID, &a.Text, &a.Choice); err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		result[questionID] = append(result[questionID], a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return result, nil
+}
+
+func insertPossibleAnswers(ctx context.Context, tx *sql.Tx, questionID uint,
+	answers []entity.PossibleAnswer) ([]entity.PossibleAnswer, error) {
+	inserted := make([]entity.PossibleAnswer, 0, len(answers))
+
+	for _, a := range answers {
+		res, err := tx.ExecContext(ctx, `insert into possible_answers(question_id, text, choice) values(?, ?, ?)`,
+			questionID, a.Text, a.Choice)
+		if err != nil {
+			return nil, err
+		}
+
+		id, _ := res.LastInsertId()
+		a.ID = uint(id)
+		inserted = append(inserted, a)
+	}
+
+	return inserted, nil
+}
+
+func scanQuestion(scanner mysql.Scanner) (entity.Question, error) {
+	var q entity.Question
+
+	err := scanner.Scan(&q.ID, &q.Text, &q.CorrectAnswer, &q.Difficulty, &q.Category)
+
+	return q, err
+}
@@ -0,0 +1,107 @@
+package mysqlrating
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"gameAppProject/repository/mysql"
+	"strings"
+)
+
+// GetRatings returns the stored ratings, users who haven't played in the category are not in the result.
+func (d *DB) GetRatings(ctx context.Context, category entity.Category, userIDs []uint) ([]entity.Rating, error) {
+	const op = "mysqlrating.GetRatings"
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	args := make([]any, 0, len(userIDs)+1)
+	args = append(args, category)
+	for _, id := range userIDs {
+		args = append(args, id)
+	}
+
+	// warning: this query works if we have one or more user id
+	query := "select user_id, category, rating, games_played from user_ratings where category = ? and user_id in (?" +
+		strings.Repeat(",?", len(userIDs)-1) +
+		")"
+
+	rows, err := d.conn.Conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	ratings := make([]entity.Rating, 0, len(userIDs))
+
+	for rows.Next() {
+		r, err := scanRating(rows)
+		if err != nil {
+			return nil, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		ratings = append(ratings, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return ratings, nil
+}
+
+// ApplyRatingChanges adds the rating changes of a game in one transaction, a user without a rating
+// starts from initialRating, it returns false if the changes of the game have already been applied.
+func (d *DB) ApplyRatingChanges(ctx context.Context, gameID uint, initialRating int,
+	changes []entity.RatingChange) (bool, error) {
+	const op = "mysqlrating.ApplyRatingChanges"
+
+	tx, err := d.conn.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	// rollback is a no-op after a successful commit
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `insert ignore into rated_games(game_id) values(?)`, gameID)
+	if err != nil {
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	// error is always nil
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return false, nil
+	}
+
+	for _, c := range changes {
+		if _, err := tx.ExecContext(ctx,
+			`insert into user_ratings(user_id, category, rating, games_played) values(?, ?, ?, 1)
+				on duplicate key update rating = rating + ?, games_played = games_played + 1`,
+			c.UserID, c.Category, initialRating+c.Delta, c.Delta); err != nil {
+			return false, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return true, nil
+}
+
+func scanRating(scanner mysql.Scanner) (entity.Rating, error) {
+	var r entity.Rating
+
+	err := scanner.Scan(&r.UserID, &r.Category, &r.Rating, &r.GamesPlayed)
+
+	return r, err
+}
@@ -0,0 +1,128 @@
+package mysqluser
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"strings"
+	"time"
+)
+
+// likeEscaper escapes the wildcards of the user input in a like pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ListUsers returns one page of users and the number of all users that match the filter,
+// deleted users are not listed.
+func (d *DB) ListUsers(ctx context.Context, filter entity.UserFilter, sort entity.UserSort,
+	offset, limit uint) ([]entity.User, uint, error) {
+	const op = "mysql.ListUsers"
+
+	where := "deleted_at is null"
+	args := make([]any, 0)
+	if filter.Role != 0 {
+		where += " and role = ?"
+		args = append(args, filter.Role.String())
+	}
+
+	if filter.PhoneNumber != "" {
+		where += " and phone_number like ?"
+		args = append(args, likeEscaper.Replace(filter.PhoneNumber)+"%")
+	}
+
+	if filter.Name != "" {
+		where += " and name like ?"
+		args = append(args, "%"+likeEscaper.Replace(filter.Name)+"%")
+	}
+
+	var total uint
+	if err := d.conn.Conn().QueryRowContext(ctx, "select count(*) from users where "+where, args...).
+		Scan(&total); err != nil {
+		return nil, 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+	}
+
+	// the sort field is checked against the valid fields, so it's safe to put it in the query
+	sortField := entity.UserSortByID
+	if sort.Field.IsValid() {
+		sortField = sort.Field
+	}
+
+	order := "asc"
+	if sort.Descending {
+		order = "desc"
+	}
+
+	rows, err := d.conn.Conn().QueryContext(ctx,
+		"select * from users where "+where+" order by "+string(sortField)+" "+order+", id limit ? offset ?",
+		append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+	defer rows.Close()
+
+	users := make([]entity.User, 0)
+
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, richerror.New(op).WithErr(err).
+				WithMessage(errmsg.ErrorMsgCantScanQueryResult).WithKind(richerror.KindUnexpected)
+		}
+
+		users = append(users, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return users, total, nil
+}
+
+func (d *DB) DeleteUser(ctx context.Context, userID uint) error {
+	const op = "mysql.DeleteUser"
+
+	_, err := d.conn.Conn().ExecContext(ctx, `update users set deleted_at = ? where id = ? and deleted_at is null`,
+		time.Now(), userID)
+	if err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// SetBanned bans or unbans the user, banning a banned user keeps the first ban time.
+func (d *DB) SetBanned(ctx context.Context, userID uint, banned bool) error {
+	const op = "mysql.SetBanned"
+
+	var err error
+	if banned {
+		_, err = d.conn.Conn().ExecContext(ctx, `update users set banned_at = ? where id = ? and banned_at is null`,
+			time.Now(), userID)
+	} else {
+		_, err = d.conn.Conn().ExecContext(ctx, `update users set banned_at = null where id = ?`, userID)
+	}
+
+	if err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (d *DB) UpdateRole(ctx context.Context, userID uint, role entity.Role) error {
+	const op = "mysql.UpdateRole"
+
+	_, err := d.conn.Conn().ExecContext(ctx, `update users set role = ? where id = ?`, role.String(), userID)
+	if err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
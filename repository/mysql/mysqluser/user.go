@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"gameAppProject/entity"
 	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/event"
 	"gameAppProject/pkg/richerror"
 	"gameAppProject/repository/mysql"
+	"strconv"
 	"time"
 )
 
@@ -29,8 +31,18 @@ func (d *DB) IsPhoneNumberUnique(phoneNumber string) (bool, error) {
 	return false, nil
 }
 
+// Register saves the user registered event in the same transaction.
 func (d *DB) Register(u entity.User) (entity.User, error) {
-	res, err := d.conn.Conn().Exec(`insert into users(name, phone_number, password, role) values(?, ?, ?, ?)`,
+	ctx := context.Background()
+
+	tx, err := d.conn.Conn().BeginTx(ctx, nil)
+	if err != nil {
+		return entity.User{}, fmt.Errorf("can't begin transaction: %w", err)
+	}
+	// rollback is a no-op after a successful commit
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `insert into users(name, phone_number, password, role) values(?, ?, ?, ?)`,
 		u.Name, u.PhoneNumber, u.Password, u.Role.String())
 	if err != nil {
 		return entity.User{}, fmt.Errorf("can't execute command: %w", err)
@@ -40,13 +52,31 @@ func (d *DB) Register(u entity.User) (entity.User, error) {
 	id, _ := res.LastInsertId()
 	u.ID = uint(id)
 
+	e, err := event.New(entity.UserRegisteredEvent, 1, strconv.FormatUint(uint64(u.ID), 10), entity.UserRegistered{
+		UserID:      u.ID,
+		PhoneNumber: u.PhoneNumber,
+		Name:        u.Name,
+	})
+	if err != nil {
+		return entity.User{}, fmt.Errorf("can't create event: %w", err)
+	}
+
+	if err := mysql.InsertOutboxEvents(ctx, tx, e); err != nil {
+		return entity.User{}, fmt.Errorf("can't execute command: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return entity.User{}, fmt.Errorf("can't commit transaction: %w", err)
+	}
+
 	return u, nil
 }
 
 func (d *DB) GetUserByPhoneNumber(phoneNumber string) (entity.User, error) {
 	const op = "mysql.GetUserByPhoneNumber"
 
-	row := d.conn.Conn().QueryRow(`select * from users where phone_number = ?`, phoneNumber)
+	row := d.conn.Conn().QueryRow(`select * from users where phone_number = ? and deleted_at is null`,
+		phoneNumber)
 
 	user, err := scanUser(row)
 	if err != nil {
@@ -66,7 +96,7 @@ func (d *DB) GetUserByPhoneNumber(phoneNumber string) (entity.User, error) {
 func (d *DB) GetUserByID(ctx context.Context, userID uint) (entity.User, error) {
 	const op = "mysql.GetUserByID"
 
-	row := d.conn.Conn().QueryRow(`select * from users where id = ?`, userID)
+	row := d.conn.Conn().QueryRow(`select * from users where id = ? and deleted_at is null`, userID)
 	user, err := scanUser(row)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -82,14 +112,71 @@ func (d *DB) GetUserByID(ctx context.Context, userID uint) (entity.User, error)
 }
 
 func scanUser(scanner mysql.Scanner) (entity.User, error) {
-	var createdAt time.Time
 	var user entity.User
 
 	var roleStr string
+	var verifiedAt, bannedAt, deletedAt sql.NullTime
 
-	err := scanner.Scan(&user.ID, &user.Name, &user.PhoneNumber, &createdAt, &user.Password, &roleStr)
+	err := scanner.Scan(&user.ID, &user.Name, &user.PhoneNumber, &user.CreatedAt, &user.Password, &roleStr, &verifiedAt,
+		&user.Avatar, &bannedAt, &deletedAt)
 
 	user.Role = entity.MapToRoleEntity(roleStr)
+	if verifiedAt.Valid {
+		user.VerifiedAt = verifiedAt.Time
+	}
+
+	if bannedAt.Valid {
+		user.BannedAt = bannedAt.Time
+	}
 
 	return user, err
 }
+
+func (d *DB) UpdatePassword(ctx context.Context, userID uint, hashedPassword string) error {
+	const op = "mysql.UpdatePassword"
+
+	_, err := d.conn.Conn().ExecContext(ctx, `update users set password = ? where id = ?`, hashedPassword, userID)
+	if err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (d *DB) VerifyPhoneNumber(ctx context.Context, userID uint) error {
+	const op = "mysql.VerifyPhoneNumber"
+
+	_, err := d.conn.Conn().ExecContext(ctx, `update users set verified_at = ? where id = ? and verified_at is null`,
+		time.Now(), userID)
+	if err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (d *DB) UpdateName(ctx context.Context, userID uint, name string) error {
+	const op = "mysql.UpdateName"
+
+	_, err := d.conn.Conn().ExecContext(ctx, `update users set name = ? where id = ?`, name, userID)
+	if err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (d *DB) UpdateAvatar(ctx context.Context, userID uint, avatar string) error {
+	const op = "mysql.UpdateAvatar"
+
+	_, err := d.conn.Conn().ExecContext(ctx, `update users set avatar = ? where id = ?`, avatar, userID)
+	if err != nil {
+		return richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgSomethingWentWrong).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"gameAppProject/pkg/event"
+)
+
+// Execer is a *sql.DB or a *sql.Tx.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// InsertOutboxEvents saves the events to be published by the outbox relay,
+// pass the transaction of the change so the events are saved only if the change is committed.
+func InsertOutboxEvents(ctx context.Context, execer Execer, events ...event.Event) error {
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+
+		if _, err := execer.ExecContext(ctx,
+			`insert into outbox_events(event_id, event_type, aggregate_id, payload) values(?, ?, ?, ?)`,
+			e.ID, e.Type, e.AggregateID, payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package redisaccesscontrol
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/richerror"
+	"github.com/redis/go-redis/v9"
+	"strings"
+	"time"
+)
+
+// setScript only sets the ttl of a new hash, so all the fields expire together,
+// the hash of an old version is never read again and it expires by itself.
+var setScript = redis.NewScript(`
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[3])
+end
+
+return 1
+`)
+
+// the titles are lowercase words separated by dashes, so they never contain a comma
+const titleSeparator = ","
+
+func (d DB) GetPermissionTitles(ctx context.Context, key, field string) ([]entity.PermissionTitle, bool, error) {
+	const op = richerror.Op("redisaccesscontrol.GetPermissionTitles")
+
+	value, err := d.adapter.Client().HGet(ctx, key, field).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, false, nil
+		}
+
+		return nil, false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	titles := make([]entity.PermissionTitle, 0)
+	if value == "" {
+		return titles, true, nil
+	}
+
+	for _, t := range strings.Split(value, titleSeparator) {
+		titles = append(titles, entity.PermissionTitle(t))
+	}
+
+	return titles, true, nil
+}
+
+func (d DB) SetPermissionTitles(ctx context.Context, key, field string,
+	titles []entity.PermissionTitle, ttl time.Duration) error {
+	const op = richerror.Op("redisaccesscontrol.SetPermissionTitles")
+
+	values := make([]string, 0, len(titles))
+	for _, t := range titles {
+		values = append(values, string(t))
+	}
+
+	if err := setScript.Run(ctx, d.adapter.Client(), []string{key},
+		field, strings.Join(values, titleSeparator), ttl.Milliseconds()).Err(); err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (d DB) GetVersion(ctx context.Context, key string) (int64, error) {
+	const op = richerror.Op("redisaccesscontrol.GetVersion")
+
+	version, err := d.adapter.Client().Get(ctx, key).Int64()
+	if err != nil {
+		if err == redis.Nil {
+			return 0, nil
+		}
+
+		return 0, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return version, nil
+}
+
+func (d DB) IncrementVersion(ctx context.Context, key string) error {
+	const op = richerror.Op("redisaccesscontrol.IncrementVersion")
+
+	if err := d.adapter.Client().Incr(ctx, key).Err(); err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
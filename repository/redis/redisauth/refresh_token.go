@@ -0,0 +1,38 @@
+package redisauth
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/pkg/richerror"
+	"time"
+)
+
+// TODO - add to config in usecase layer...
+const RefreshTokenPrefix = "refresh_token"
+
+func (d DB) StoreRefreshToken(ctx context.Context, tokenID string, userID uint, expTime time.Duration) error {
+	const op = richerror.Op("redisauth.StoreRefreshToken")
+
+	_, err := d.adapter.Client().Set(ctx, getRefreshTokenKey(tokenID), userID, expTime).Result()
+	if err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (d DB) DeleteRefreshToken(ctx context.Context, tokenID string) (bool, error) {
+	const op = richerror.Op("redisauth.DeleteRefreshToken")
+
+	// DEL is atomic, only one of the concurrent requests with the same token deletes the key
+	deleted, err := d.adapter.Client().Del(ctx, getRefreshTokenKey(tokenID)).Result()
+	if err != nil {
+		return false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return deleted == 1, nil
+}
+
+func getRefreshTokenKey(tokenID string) string {
+	return fmt.Sprintf("%s:%s", RefreshTokenPrefix, tokenID)
+}
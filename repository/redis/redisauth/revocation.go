@@ -0,0 +1,88 @@
+package redisauth
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/pkg/richerror"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+// TODO - add to config in usecase layer...
+const (
+	RevokedTokenPrefix     = "revoked_token"
+	TokensValidAfterPrefix = "tokens_valid_after_ms"
+	// the watermarks were stored in seconds, they're read until they expire
+	legacyTokensValidAfterPrefix = "tokens_valid_after"
+)
+
+// RevokeToken keeps the token id in the deny list until the token expires by itself.
+func (d DB) RevokeToken(ctx context.Context, tokenID string, expTime time.Duration) error {
+	const op = richerror.Op("redisauth.RevokeToken")
+
+	_, err := d.adapter.Client().Set(ctx, getRevokedTokenKey(tokenID), 1, expTime).Result()
+	if err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// SetTokensValidAfter invalidates all tokens of the user that are issued before the given unix time in milliseconds.
+func (d DB) SetTokensValidAfter(ctx context.Context, userID uint, unixMilli int64, expTime time.Duration) error {
+	const op = richerror.Op("redisauth.SetTokensValidAfter")
+
+	_, err := d.adapter.Client().Set(ctx, getTokensValidAfterKey(userID), unixMilli, expTime).Result()
+	if err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// GetTokenRevocation returns whether the token id is in the deny list,
+// and the user's watermark in unix milliseconds, zero means the user has no watermark.
+func (d DB) GetTokenRevocation(ctx context.Context, tokenID string, userID uint) (bool, int64, error) {
+	const op = richerror.Op("redisauth.GetTokenRevocation")
+
+	values, err := d.adapter.Client().MGet(ctx, getRevokedTokenKey(tokenID), getTokensValidAfterKey(userID),
+		getLegacyTokensValidAfterKey(userID)).Result()
+	if err != nil && err != redis.Nil {
+		return false, 0, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	isRevoked := values[0] != nil
+
+	var validAfter int64
+	if str, ok := values[1].(string); ok {
+		validAfter, err = strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return false, 0, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+		}
+	}
+
+	// a legacy watermark covers the whole second
+	if str, ok := values[2].(string); ok {
+		seconds, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return false, 0, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+		}
+
+		validAfter = max(validAfter, seconds*1000+999)
+	}
+
+	return isRevoked, validAfter, nil
+}
+
+func getRevokedTokenKey(tokenID string) string {
+	return fmt.Sprintf("%s:%s", RevokedTokenPrefix, tokenID)
+}
+
+func getTokensValidAfterKey(userID uint) string {
+	return fmt.Sprintf("%s:%d", TokensValidAfterPrefix, userID)
+}
+
+func getLegacyTokensValidAfterKey(userID uint) string {
+	return fmt.Sprintf("%s:%d", legacyTokensValidAfterPrefix, userID)
+}
@@ -0,0 +1,71 @@
+package redisloginguard
+
+import (
+	"context"
+	"gameAppProject/pkg/richerror"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// incrementScript extends the window on every failure, so the counter lives while the failures go on.
+var incrementScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+redis.call("PEXPIRE", KEYS[1], ARGV[1])
+
+return count
+`)
+
+func (d DB) IncrementFailedAttempts(ctx context.Context, key string, window time.Duration) (int64, error) {
+	const op = richerror.Op("redisloginguard.IncrementFailedAttempts")
+
+	count, err := incrementScript.Run(ctx, d.adapter.Client(), []string{key}, window.Milliseconds()).Int64()
+	if err != nil {
+		return 0, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return count, nil
+}
+
+func (d DB) ResetFailedAttempts(ctx context.Context, key string) error {
+	const op = richerror.Op("redisloginguard.ResetFailedAttempts")
+
+	if _, err := d.adapter.Client().Del(ctx, key).Result(); err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+func (d DB) Lock(ctx context.Context, key string, duration time.Duration) error {
+	const op = richerror.Op("redisloginguard.Lock")
+
+	if _, err := d.adapter.Client().Set(ctx, key, 1, duration).Result(); err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// GetLockTTLs returns the remaining lock time of every key, zero means the key is not locked.
+func (d DB) GetLockTTLs(ctx context.Context, keys []string) ([]time.Duration, error) {
+	const op = richerror.Op("redisloginguard.GetLockTTLs")
+
+	cmds := make([]*redis.DurationCmd, 0, len(keys))
+	if _, err := d.adapter.Client().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, k := range keys {
+			cmds = append(cmds, pipe.PTTL(ctx, k))
+		}
+
+		return nil
+	}); err != nil {
+		return nil, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	ttls := make([]time.Duration, 0, len(keys))
+	for _, c := range cmds {
+		// PTTL is negative for the missing keys
+		ttls = append(ttls, max(c.Val(), 0))
+	}
+
+	return ttls, nil
+}
@@ -14,20 +14,75 @@ import (
 // TODO - add to config in usecase layer...
 const WaitingListPrefix = "waitinglist"
 
-func (d DB) AddToWaitingList(userID uint, category entity.Category) error {
+// addToWaitingListScript adds the member to the first key only if it isn't waiting in the other keys,
+// the members that have joined before ARGV[3] are stale and are removed instead.
+// a member that is already waiting in the first key keeps its score, so it doesn't lose its turn.
+var addToWaitingListScript = redis.NewScript(`
+local staleBefore = tonumber(ARGV[3])
+for i = 2, #KEYS do
+	local score = redis.call("ZSCORE", KEYS[i], ARGV[1])
+	if score then
+		if tonumber(score) >= staleBefore then
+			return 0
+		end
+		redis.call("ZREM", KEYS[i], ARGV[1])
+	end
+end
+local score = redis.call("ZSCORE", KEYS[1], ARGV[1])
+if score == false or tonumber(score) < staleBefore then
+	redis.call("ZADD", KEYS[1], ARGV[2], ARGV[1])
+end
+return 1
+`)
+
+// AddToWaitingList adds the user to the waiting list of the category, it returns false
+// if the user is still waiting in the list of another one of the given categories.
+func (d DB) AddToWaitingList(ctx context.Context, userID uint, category entity.Category,
+	categories []entity.Category, staleBefore int64) (bool, error) {
 	const op = richerror.Op("redismatching.AddToWaitingList")
 
-	_, err := d.adapter.Client().
-		ZAdd(context.Background(),
-			fmt.Sprintf("%s:%s", WaitingListPrefix, category),
-			redis.Z{Score: float64(timestamp.Now()),
-				Member: fmt.Sprintf("%d", userID),
-			}).Result()
+	added, err := d.addToWaitingList(ctx, entity.WaitingMember{
+		UserID:    userID,
+		Timestamp: timestamp.Now(),
+		Category:  category,
+	}, categories, staleBefore)
 	if err != nil {
-		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+		return false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
 	}
 
-	return nil
+	return added, nil
+}
+
+// RestoreToWaitingList adds the member back with the time it has joined, so it keeps its turn,
+// it returns false if the user is waiting in the list of another one of the given categories.
+func (d DB) RestoreToWaitingList(ctx context.Context, member entity.WaitingMember,
+	categories []entity.Category) (bool, error) {
+	const op = richerror.Op("redismatching.RestoreToWaitingList")
+
+	added, err := d.addToWaitingList(ctx, member, categories, 0)
+	if err != nil {
+		return false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return added, nil
+}
+
+func (d DB) addToWaitingList(ctx context.Context, member entity.WaitingMember,
+	categories []entity.Category, staleBefore int64) (bool, error) {
+	keys := []string{getCategoryKey(member.Category)}
+	for _, c := range categories {
+		if c != member.Category {
+			keys = append(keys, getCategoryKey(c))
+		}
+	}
+
+	added, err := addToWaitingListScript.Run(ctx, d.adapter.Client(), keys,
+		fmt.Sprintf("%d", member.UserID), member.Timestamp, staleBefore).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return added == 1, nil
 }
 
 func (d DB) GetWaitingListByCategory(ctx context.Context, category entity.Category) ([]entity.WaitingMember, error) {
@@ -64,3 +119,155 @@ func (d DB) GetWaitingListByCategory(ctx context.Context, category entity.Catego
 func getCategoryKey(category entity.Category) string {
 	return fmt.Sprintf("%s:%s", WaitingListPrefix, category)
 }
+
+// removeWaitingMembersScript removes the given members only if all of them are still waiting,
+// so the same user can't be claimed by two games, it returns the scores of the removed members.
+var removeWaitingMembersScript = redis.NewScript(`
+local scores = {}
+for i, member in ipairs(ARGV) do
+	local score = redis.call("ZSCORE", KEYS[1], member)
+	if score == false then
+		return {}
+	end
+	scores[i] = score
+end
+redis.call("ZREM", KEYS[1], unpack(ARGV))
+return scores
+`)
+
+// RemoveMatchedUsersFromWaitingList returns the removed members with the time they have joined,
+// it returns false and removes nobody if any of the users isn't waiting.
+func (d DB) RemoveMatchedUsersFromWaitingList(ctx context.Context, category entity.Category, userIDs []uint) (
+	[]entity.WaitingMember, bool, error) {
+	const op = richerror.Op("redismatching.RemoveMatchedUsersFromWaitingList")
+
+	members := make([]interface{}, 0, len(userIDs))
+	for _, userID := range userIDs {
+		members = append(members, fmt.Sprintf("%d", userID))
+	}
+
+	scores, err := removeWaitingMembersScript.Run(ctx, d.adapter.Client(),
+		[]string{getCategoryKey(category)}, members...).StringSlice()
+	if err != nil {
+		return nil, false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	if len(userIDs) == 0 || len(scores) != len(userIDs) {
+		return nil, false, nil
+	}
+
+	removed := make([]entity.WaitingMember, 0, len(userIDs))
+	for i, userID := range userIDs {
+		score, err := strconv.ParseFloat(scores[i], 64)
+		if err != nil {
+			return nil, false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+		}
+
+		removed = append(removed, entity.WaitingMember{UserID: userID, Timestamp: int64(score), Category: category})
+	}
+
+	return removed, true, nil
+}
+
+func (d DB) RemoveUsersFromWaitingList(ctx context.Context, category entity.Category, userIDs []uint) error {
+	const op = richerror.Op("redismatching.RemoveUsersFromWaitingList")
+
+	members := make([]interface{}, 0, len(userIDs))
+	for _, userID := range userIDs {
+		members = append(members, fmt.Sprintf("%d", userID))
+	}
+
+	if _, err := d.adapter.Client().ZRem(ctx, getCategoryKey(category), members...).Result(); err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// RemoveStaleUsersFromWaitingList removes members that have joined the waiting list before the given timestamp.
+func (d DB) RemoveStaleUsersFromWaitingList(ctx context.Context, category entity.Category, before int64) error {
+	const op = richerror.Op("redismatching.RemoveStaleUsersFromWaitingList")
+
+	if _, err := d.adapter.Client().ZRemRangeByScore(ctx, getCategoryKey(category),
+		"-inf", fmt.Sprintf("(%d", before)).Result(); err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// GetWaitingMember looks the user up in the waiting list of the given categories.
+func (d DB) GetWaitingMember(ctx context.Context, userID uint, categories []entity.Category) (entity.WaitingMember, bool, error) {
+	const op = richerror.Op("redismatching.GetWaitingMember")
+
+	member := fmt.Sprintf("%d", userID)
+
+	pipe := d.adapter.Client().Pipeline()
+	cmds := make([]*redis.FloatCmd, 0, len(categories))
+	for _, category := range categories {
+		cmds = append(cmds, pipe.ZScore(ctx, getCategoryKey(category), member))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return entity.WaitingMember{}, false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	for i, cmd := range cmds {
+		score, err := cmd.Result()
+		if err == redis.Nil {
+			continue
+		}
+
+		if err != nil {
+			return entity.WaitingMember{}, false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+		}
+
+		return entity.WaitingMember{UserID: userID, Timestamp: int64(score), Category: categories[i]}, true, nil
+	}
+
+	return entity.WaitingMember{}, false, nil
+}
+
+// RemoveUserFromWaitingLists removes the user from the waiting list of all the given categories,
+// it returns false if the user wasn't in any of them.
+func (d DB) RemoveUserFromWaitingLists(ctx context.Context, userID uint, categories []entity.Category) (bool, error) {
+	const op = richerror.Op("redismatching.RemoveUserFromWaitingLists")
+
+	member := fmt.Sprintf("%d", userID)
+
+	pipe := d.adapter.Client().Pipeline()
+	cmds := make([]*redis.IntCmd, 0, len(categories))
+	for _, category := range categories {
+		cmds = append(cmds, pipe.ZRem(ctx, getCategoryKey(category), member))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	var removed int64
+	for _, cmd := range cmds {
+		removed += cmd.Val()
+	}
+
+	return removed > 0, nil
+}
+
+// GetWaitingListPosition returns the position of the member in its waiting list and the size of the list,
+// the members that have joined before staleBefore aren't counted.
+func (d DB) GetWaitingListPosition(ctx context.Context, member entity.WaitingMember, staleBefore int64) (int, int, error) {
+	const op = richerror.Op("redismatching.GetWaitingListPosition")
+
+	key := getCategoryKey(member.Category)
+	min := fmt.Sprintf("%d", staleBefore)
+
+	pipe := d.adapter.Client().Pipeline()
+	ahead := pipe.ZCount(ctx, key, min, fmt.Sprintf("(%d", member.Timestamp))
+	size := pipe.ZCount(ctx, key, min, "+inf")
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, 0, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return int(ahead.Val()) + 1, int(size.Val()), nil
+}
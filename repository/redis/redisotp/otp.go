@@ -0,0 +1,76 @@
+package redisotp
+
+import (
+	"context"
+	"gameAppProject/pkg/richerror"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// verifyCodeScript counts the attempt before comparing, the code is deleted
+// when it matches or when there is no attempt left.
+// the attempts are kept in their own key, so a new code doesn't reset them.
+var verifyCodeScript = redis.NewScript(`
+local maxAttempts = tonumber(ARGV[2])
+if tonumber(redis.call("GET", KEYS[2]) or "0") >= maxAttempts then
+	return 0
+end
+
+local code = redis.call("HGET", KEYS[1], "code")
+if not code then
+	return 0
+end
+
+local attempts = redis.call("INCR", KEYS[2])
+if attempts == 1 then
+	redis.call("PEXPIRE", KEYS[2], ARGV[3])
+end
+
+if code == ARGV[1] then
+	redis.call("DEL", KEYS[1], KEYS[2])
+	return 1
+end
+
+if attempts >= maxAttempts then
+	redis.call("DEL", KEYS[1])
+end
+
+return 0
+`)
+
+// StoreCode replaces the previous code of the key, the attempts of the key are not reset.
+func (d DB) StoreCode(ctx context.Context, key, hashedCode string, expTime time.Duration) error {
+	const op = richerror.Op("redisotp.StoreCode")
+
+	_, err := d.adapter.Client().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, "code", hashedCode)
+		pipe.Expire(ctx, key, expTime)
+
+		return nil
+	})
+	if err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// VerifyCode returns false if the code doesn't match, is expired or the key has no attempt left,
+// the attempts of the key are counted in the attempts window, whichever code they are made for.
+func (d DB) VerifyCode(ctx context.Context, key, hashedCode string, maxAttempts int,
+	attemptsWindow time.Duration) (bool, error) {
+	const op = richerror.Op("redisotp.VerifyCode")
+
+	res, err := verifyCodeScript.Run(ctx, d.adapter.Client(), []string{key, getAttemptsKey(key)},
+		hashedCode, maxAttempts, attemptsWindow.Milliseconds()).Int()
+	if err != nil {
+		return false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return res == 1, nil
+}
+
+func getAttemptsKey(key string) string {
+	return key + ":attempts"
+}
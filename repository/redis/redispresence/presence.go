@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"gameAppProject/pkg/richerror"
+	"strconv"
 	"time"
 )
 
@@ -17,3 +18,37 @@ func (d DB) Upsert(ctx context.Context, key string, timestamp int64, expTime tim
 
 	return nil
 }
+
+// GetPresence returns the stored timestamp of every existing key, expired keys are not in the result.
+func (d DB) GetPresence(ctx context.Context, keys []string) (map[string]int64, error) {
+	const op = richerror.Op("redispresence.GetPresence")
+
+	result := make(map[string]int64, len(keys))
+
+	if len(keys) == 0 {
+		return result, nil
+	}
+
+	values, err := d.adapter.Client().MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	for i, v := range values {
+		// MGET returns nil for keys that don't exist
+		str, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		timestamp, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected).
+				WithMeta(map[string]interface{}{"key": keys[i]})
+		}
+
+		result[keys[i]] = timestamp
+	}
+
+	return result, nil
+}
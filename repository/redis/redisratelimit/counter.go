@@ -0,0 +1,30 @@
+package redisratelimit
+
+import (
+	"context"
+	"gameAppProject/pkg/richerror"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// incrementScript starts the window on the first hit, so the counter expires a window after the first hit.
+var incrementScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+
+return {count, redis.call("PTTL", KEYS[1])}
+`)
+
+// Increment returns the hits of the current window including this one, and the remaining time of the window.
+func (d DB) Increment(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	const op = richerror.Op("redisratelimit.Increment")
+
+	res, err := incrementScript.Run(ctx, d.adapter.Client(), []string{key}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return res[0], time.Duration(res[1]) * time.Millisecond, nil
+}
@@ -3,36 +3,66 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"gameAppProject/adapter/redis"
 	"gameAppProject/param"
-	"gameAppProject/service/matchingservice"
 	"github.com/go-co-op/gocron"
 	"sync"
 	"time"
 )
 
+const (
+	matchWaitedUsersLockKey = "lock:scheduler:match-waited-users"
+	relayOutboxLockKey      = "lock:scheduler:relay-outbox"
+)
+
 type Config struct {
-	MatchWaitedUsersIntervalInSeconds int `koanf:"match_waited_users_interval_in_seconds"`
+	MatchWaitedUsersIntervalInSeconds int           `koanf:"match_waited_users_interval_in_seconds"`
+	MatchWaitedUsersTimeout           time.Duration `koanf:"match_waited_users_timeout"`
+	LockTTL                           time.Duration `koanf:"lock_ttl"`
+	RelayOutboxIntervalInSeconds      int           `koanf:"relay_outbox_interval_in_seconds"`
+	RelayOutboxTimeout                time.Duration `koanf:"relay_outbox_timeout"`
+}
+
+type Locker interface {
+	Lock(ctx context.Context, key string, ttl time.Duration) (redis.Lock, bool, error)
+}
+
+type MatchingService interface {
+	MatchWaitedUsers(ctx context.Context, req param.MatchWaitedUsersRequest) (param.MatchWaitedUsersResponse, error)
+}
+
+type OutboxService interface {
+	Relay(ctx context.Context, req param.RelayOutboxRequest) (param.RelayOutboxResponse, error)
 }
 
 type Scheduler struct {
-	sch      *gocron.Scheduler
-	matchSvc matchingservice.Service
-	config   Config
+	sch       *gocron.Scheduler
+	matchSvc  MatchingService
+	outboxSvc OutboxService
+	locker    Locker
+	config    Config
 }
 
-func New(config Config, matchSvc matchingservice.Service) Scheduler {
+// New creates a scheduler that runs the jobs of the given services, a nil service means its job runs in another binary.
+func New(config Config, matchSvc MatchingService, outboxSvc OutboxService, locker Locker) Scheduler {
 	return Scheduler{
-		config:   config,
-		matchSvc: matchSvc,
-		sch:      gocron.NewScheduler(time.UTC)}
+		config:    config,
+		matchSvc:  matchSvc,
+		outboxSvc: outboxSvc,
+		locker:    locker,
+		sch:       gocron.NewScheduler(time.UTC)}
 }
 
 func (s Scheduler) Start(done <-chan bool, wg *sync.WaitGroup) {
-	// TODO - add lock or distributed lock
-
 	defer wg.Done()
 
-	s.sch.Every(s.config.MatchWaitedUsersIntervalInSeconds).Second().Do(s.MatchWaitedUsers)
+	if s.matchSvc != nil {
+		s.sch.Every(s.config.MatchWaitedUsersIntervalInSeconds).Second().Do(s.MatchWaitedUsers)
+	}
+
+	if s.outboxSvc != nil {
+		s.sch.Every(s.config.RelayOutboxIntervalInSeconds).Second().Do(s.RelayOutbox)
+	}
 
 	s.sch.StartAsync()
 
@@ -43,15 +73,77 @@ func (s Scheduler) Start(done <-chan bool, wg *sync.WaitGroup) {
 }
 
 func (s Scheduler) MatchWaitedUsers() {
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.MatchWaitedUsersTimeout)
 	defer cancel()
 
-	// get lock
-	_, err := s.matchSvc.MatchWaitedUsers(ctx, param.MatchWaitedUsersRequest{})
+	// only one instance runs a matching round at a time
+	lock, acquired, err := s.locker.Lock(ctx, matchWaitedUsersLockKey, s.config.LockTTL)
+	if err != nil {
+		// TODO - log err
+		fmt.Println("locker.Lock error", err)
+
+		return
+	}
+
+	if !acquired {
+		return
+	}
+
+	defer func() {
+		// ctx may be canceled here, so release the lock with a fresh context
+		releaseCtx, releaseCancel := context.WithTimeout(context.Background(), time.Second)
+		defer releaseCancel()
+
+		if err := lock.Release(releaseCtx); err != nil {
+			// TODO - log err
+			fmt.Println("lock.Release error", err)
+		}
+	}()
+
+	// a round that takes longer than the lock ttl keeps the lock, and stops if the lock is lost
+	lock.KeepAlive(ctx, cancel)
+
+	_, err = s.matchSvc.MatchWaitedUsers(ctx, param.MatchWaitedUsersRequest{})
 	if err != nil {
 		// TODO - log err
 		// TODO - update metrics
 		fmt.Println("matchSvc.MatchWaitedUsers error", err)
 	}
-	// free lock
+}
+
+func (s Scheduler) RelayOutbox() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.RelayOutboxTimeout)
+	defer cancel()
+
+	// the events of an aggregate are published in order only if one instance relays them
+	lock, acquired, err := s.locker.Lock(ctx, relayOutboxLockKey, s.config.LockTTL)
+	if err != nil {
+		// TODO - log err
+		fmt.Println("locker.Lock error", err)
+
+		return
+	}
+
+	if !acquired {
+		return
+	}
+
+	defer func() {
+		releaseCtx, releaseCancel := context.WithTimeout(context.Background(), time.Second)
+		defer releaseCancel()
+
+		if err := lock.Release(releaseCtx); err != nil {
+			// TODO - log err
+			fmt.Println("lock.Release error", err)
+		}
+	}()
+
+	lock.KeepAlive(ctx, cancel)
+
+	_, err = s.outboxSvc.Relay(ctx, param.RelayOutboxRequest{})
+	if err != nil {
+		// TODO - log err
+		// TODO - update metrics
+		fmt.Println("outboxSvc.Relay error", err)
+	}
 }
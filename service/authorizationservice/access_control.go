@@ -0,0 +1,103 @@
+package authorizationservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+)
+
+func (s Service) GrantAccess(ctx context.Context, req param.AccessControlGrantRequest) (param.AccessControlGrantResponse, error) {
+	const op = "authorizationservice.GrantAccess"
+
+	meta := map[string]interface{}{
+		"actor_type":    req.ActorType,
+		"actor_id":      req.ActorID,
+		"permission_id": req.PermissionID,
+	}
+
+	if _, err := s.repo.GetPermissionByID(ctx, req.PermissionID); err != nil {
+		return param.AccessControlGrantResponse{}, richerror.New(op).WithErr(err).WithMeta(meta)
+	}
+
+	if req.ActorType == entity.UserActorType {
+		if _, err := s.userRepo.GetUserByID(ctx, req.ActorID); err != nil {
+			return param.AccessControlGrantResponse{}, richerror.New(op).WithErr(err).WithMeta(meta)
+		}
+	}
+
+	exists, err := s.repo.DoesAccessControlExist(ctx, req.ActorType, req.ActorID, req.PermissionID)
+	if err != nil {
+		return param.AccessControlGrantResponse{}, richerror.New(op).WithErr(err).WithMeta(meta)
+	}
+
+	if exists {
+		return param.AccessControlGrantResponse{}, richerror.New(op).
+			WithMessage(errmsg.ErrorMsgAccessControlAlreadyExists).WithKind(richerror.KindInvalid).WithMeta(meta)
+	}
+
+	acl, err := s.repo.CreateAccessControl(ctx, entity.AccessControl{
+		ActorType:    req.ActorType,
+		ActorID:      req.ActorID,
+		PermissionID: req.PermissionID,
+	})
+	if err != nil {
+		return param.AccessControlGrantResponse{}, richerror.New(op).WithErr(err).WithMeta(meta)
+	}
+
+	s.invalidateCache(ctx)
+
+	return param.AccessControlGrantResponse{AccessControl: toAccessControlInfo(acl)}, nil
+}
+
+// RevokeAccess doesn't let an admin revoke their own access control management, so they can't lock themselves out,
+// and it doesn't revoke the access control management from its last holder, so nobody can manage the ACLs anymore.
+func (s Service) RevokeAccess(ctx context.Context, req param.AccessControlRevokeRequest) (param.AccessControlRevokeResponse, error) {
+	const op = "authorizationservice.RevokeAccess"
+
+	acl, err := s.repo.GetAccessControlByID(ctx, req.ID)
+	if err != nil {
+		return param.AccessControlRevokeResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"access_control_id": req.ID})
+	}
+
+	p, err := s.repo.GetPermissionByID(ctx, acl.PermissionID)
+	if err != nil {
+		return param.AccessControlRevokeResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"access_control_id": req.ID})
+	}
+
+	isAdminACL := (acl.ActorType == entity.UserActorType && acl.ActorID == req.AdminID) ||
+		(acl.ActorType == entity.RoleActorType && acl.ActorID == uint(req.AdminRole))
+	if p.Title == entity.AccessControlManagePermission && isAdminACL {
+		return param.AccessControlRevokeResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgUserNotAllowed).
+			WithKind(richerror.KindForbidden).WithMeta(map[string]interface{}{"access_control_id": req.ID})
+	}
+
+	keepHolder := p.Title == entity.AccessControlManagePermission
+	if err := s.repo.DeleteAccessControl(ctx, req.ID, keepHolder); err != nil {
+		return param.AccessControlRevokeResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"access_control_id": req.ID})
+	}
+
+	s.invalidateCache(ctx)
+
+	return param.AccessControlRevokeResponse{}, nil
+}
+
+func (s Service) ListAccessControls(ctx context.Context, req param.AccessControlListRequest) (param.AccessControlListResponse, error) {
+	const op = "authorizationservice.ListAccessControls"
+
+	acls, err := s.repo.ListAccessControls(ctx, req.ActorType, req.ActorID)
+	if err != nil {
+		return param.AccessControlListResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	infos := make([]param.AccessControlInfo, 0, len(acls))
+	for _, acl := range acls {
+		infos = append(infos, toAccessControlInfo(acl))
+	}
+
+	return param.AccessControlListResponse{AccessControls: infos}, nil
+}
@@ -0,0 +1,65 @@
+package authorizationservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+// CreatePermission only adds the permission, the code has to check it before granting it does anything.
+func (s Service) CreatePermission(ctx context.Context, req param.PermissionCreateRequest) (param.PermissionCreateResponse, error) {
+	const op = "authorizationservice.CreatePermission"
+
+	p, err := s.repo.CreatePermission(ctx, entity.PermissionTitle(req.Title))
+	if err != nil {
+		return param.PermissionCreateResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"title": req.Title})
+	}
+
+	return param.PermissionCreateResponse{Permission: toPermissionInfo(p)}, nil
+}
+
+func (s Service) ListPermissions(ctx context.Context, _ param.PermissionListRequest) (param.PermissionListResponse, error) {
+	const op = "authorizationservice.ListPermissions"
+
+	permissions, err := s.repo.ListPermissions(ctx)
+	if err != nil {
+		return param.PermissionListResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	infos := make([]param.PermissionInfo, 0, len(permissions))
+	for _, p := range permissions {
+		infos = append(infos, toPermissionInfo(p))
+	}
+
+	return param.PermissionListResponse{Permissions: infos}, nil
+}
+
+// GetUserPermissions returns the permissions of the user's role and the ones granted to the user directly.
+func (s Service) GetUserPermissions(ctx context.Context, req param.UserPermissionsRequest) (param.UserPermissionsResponse, error) {
+	const op = "authorizationservice.GetUserPermissions"
+
+	user, err := s.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return param.UserPermissionsResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	permissions, err := s.repo.GetUserPermissions(ctx, user.ID, user.Role)
+	if err != nil {
+		return param.UserPermissionsResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	infos := make([]param.PermissionInfo, 0, len(permissions))
+	for _, p := range permissions {
+		infos = append(infos, toPermissionInfo(p))
+	}
+
+	return param.UserPermissionsResponse{
+		UserID:      user.ID,
+		Role:        user.Role.String(),
+		Permissions: infos,
+	}, nil
+}
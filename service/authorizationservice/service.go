@@ -1,26 +1,78 @@
 package authorizationservice
 
 import (
+	"context"
+	"fmt"
 	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/lrucache"
 	"gameAppProject/pkg/richerror"
+	"sync/atomic"
+	"time"
 )
 
+type Config struct {
+	// the in-process cache isn't shared, without RedisCache a change on another instance shows up here after CacheTTL
+	CacheSize     int           `koanf:"cache_size"`
+	CacheTTL      time.Duration `koanf:"cache_ttl"`
+	RedisCache    bool          `koanf:"redis_cache"`
+	RedisCacheTTL time.Duration `koanf:"redis_cache_ttl"`
+	Prefix        string        `koanf:"prefix"`
+}
+
 type Repository interface {
-	GetUserPermissionTitles(userID uint, role entity.Role) ([]entity.PermissionTitle, error)
+	GetUserPermissionTitles(ctx context.Context, userID uint, role entity.Role) ([]entity.PermissionTitle, error)
+	GetUserPermissions(ctx context.Context, userID uint, role entity.Role) ([]entity.Permission, error)
+	CreatePermission(ctx context.Context, title entity.PermissionTitle) (entity.Permission, error)
+	GetPermissionByID(ctx context.Context, permissionID uint) (entity.Permission, error)
+	ListPermissions(ctx context.Context) ([]entity.Permission, error)
+	CreateAccessControl(ctx context.Context, acl entity.AccessControl) (entity.AccessControl, error)
+	GetAccessControlByID(ctx context.Context, aclID uint) (entity.AccessControl, error)
+	DoesAccessControlExist(ctx context.Context, actorType entity.ActorType, actorID, permissionID uint) (bool, error)
+	DeleteAccessControl(ctx context.Context, aclID uint, keepHolder bool) error
+	ListAccessControls(ctx context.Context, actorType entity.ActorType, actorID uint) ([]entity.AccessControl, error)
+}
+
+type UserRepository interface {
+	GetUserByID(ctx context.Context, userID uint) (entity.User, error)
+}
+
+// Cache is shared between the instances, e.g. redis.
+// GetVersion returns zero when the version isn't set yet.
+type Cache interface {
+	GetVersion(ctx context.Context, key string) (int64, error)
+	IncrementVersion(ctx context.Context, key string) error
+	GetPermissionTitles(ctx context.Context, key, field string) ([]entity.PermissionTitle, bool, error)
+	SetPermissionTitles(ctx context.Context, key, field string, titles []entity.PermissionTitle, ttl time.Duration) error
 }
 
 type Service struct {
-	repo Repository
+	config     Config
+	repo       Repository
+	userRepo   UserRepository
+	cache      Cache
+	localCache *lrucache.Cache[string, []entity.PermissionTitle]
+	// localVersion is the cache version when there is no shared cache
+	localVersion *atomic.Int64
 }
 
-func New(repo Repository) Service {
-	return Service{repo: repo}
+// New doesn't use a shared cache when cache is nil.
+func New(config Config, repo Repository, userRepo UserRepository, cache Cache) Service {
+	return Service{
+		config:       config,
+		repo:         repo,
+		userRepo:     userRepo,
+		cache:        cache,
+		localCache:   lrucache.New[string, []entity.PermissionTitle](config.CacheSize, config.CacheTTL),
+		localVersion: &atomic.Int64{},
+	}
 }
 
-func (s Service) CheckAccess(userID uint, role entity.Role, permissions ...entity.PermissionTitle) (bool, error) {
+func (s Service) CheckAccess(ctx context.Context, userID uint, role entity.Role,
+	permissions ...entity.PermissionTitle) (bool, error) {
 	const op = "authorizationservice.CheckAccess"
 
-	permissionTitles, err := s.repo.GetUserPermissionTitles(userID, role)
+	permissionTitles, err := s.getPermissionTitles(ctx, userID, role)
 	if err != nil {
 		return false, richerror.New(op).WithErr(err)
 	}
@@ -35,3 +87,99 @@ func (s Service) CheckAccess(userID uint, role entity.Role, permissions ...entit
 
 	return false, nil
 }
+
+// getPermissionTitles looks up the in-process cache, then the shared cache and then the database.
+// the role is part of the key, so a role change doesn't need an invalidation.
+// the cache version is read before the database, so a load that races with an invalidation
+// is cached under the old version, which is never read again.
+func (s Service) getPermissionTitles(ctx context.Context, userID uint, role entity.Role) ([]entity.PermissionTitle, error) {
+	version, err := s.cacheVersion(ctx)
+	if err != nil {
+		// TODO - log error, the database still has the permissions
+		fmt.Println("cache.GetVersion error", err)
+
+		return s.repo.GetUserPermissionTitles(ctx, userID, role)
+	}
+
+	field := fmt.Sprintf("%d:%d", userID, role)
+	localKey := fmt.Sprintf("%d:%s", version, field)
+
+	if titles, ok := s.localCache.Get(localKey); ok {
+		return titles, nil
+	}
+
+	if s.cache != nil {
+		titles, ok, err := s.cache.GetPermissionTitles(ctx, s.cacheKey(version), field)
+		if err != nil {
+			// TODO - log error, the database still has the permissions
+			fmt.Println("cache.GetPermissionTitles error", err)
+		}
+
+		if ok {
+			s.localCache.Set(localKey, titles)
+
+			return titles, nil
+		}
+	}
+
+	titles, err := s.repo.GetUserPermissionTitles(ctx, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.localCache.Set(localKey, titles)
+
+	if s.cache != nil {
+		if err := s.cache.SetPermissionTitles(ctx, s.cacheKey(version), field, titles,
+			s.config.RedisCacheTTL); err != nil {
+			// TODO - log error
+			fmt.Println("cache.SetPermissionTitles error", err)
+		}
+	}
+
+	return titles, nil
+}
+
+// cacheVersion is shared by the instances when there is a shared cache, otherwise it's only for this instance.
+func (s Service) cacheVersion(ctx context.Context) (int64, error) {
+	if s.cache == nil {
+		return s.localVersion.Load(), nil
+	}
+
+	return s.cache.GetVersion(ctx, s.versionKey())
+}
+
+// invalidateCache drops the cached permissions of everyone, the access controls change rarely.
+// the other instances see the new version of the shared cache on their next read.
+func (s Service) invalidateCache(ctx context.Context) {
+	s.localVersion.Add(1)
+	s.localCache.Purge()
+
+	if s.cache != nil {
+		if err := s.cache.IncrementVersion(ctx, s.versionKey()); err != nil {
+			// TODO - log error, the cached permissions are stale until they expire
+			fmt.Println("cache.IncrementVersion error", err)
+		}
+	}
+}
+
+func (s Service) cacheKey(version int64) string {
+	return fmt.Sprintf("%s:permissions:%d", s.config.Prefix, version)
+}
+
+func (s Service) versionKey() string {
+	return fmt.Sprintf("%s:permissions:version", s.config.Prefix)
+}
+
+func toPermissionInfo(p entity.Permission) param.PermissionInfo {
+	return param.PermissionInfo{ID: p.ID, Title: p.Title}
+}
+
+func toAccessControlInfo(acl entity.AccessControl) param.AccessControlInfo {
+	return param.AccessControlInfo{
+		ID:           acl.ID,
+		ActorType:    acl.ActorType,
+		ActorID:      acl.ActorID,
+		PermissionID: acl.PermissionID,
+	}
+}
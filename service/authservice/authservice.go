@@ -1,36 +1,81 @@
 package authservice
 
 import (
+	"context"
+	"fmt"
 	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"strings"
 	"time"
 )
 
 type Config struct {
-	SignKey               string        `koanf:"sign_key"`
+	// SignKey is the secret of HS256, asymmetric methods use Keys instead
+	SignKey string `koanf:"sign_key"`
+	// SignMethod is one of HS256, RS256 or EdDSA, default is HS256
+	SignMethod string `koanf:"sign_method"`
+	// SignKeyID is the id of the key in Keys that signs new tokens, the others only verify
+	SignKeyID             string        `koanf:"sign_key_id"`
+	Keys                  []KeyConfig   `koanf:"keys"`
 	AccessExpirationTime  time.Duration `koanf:"access_expiration_time"`
 	RefreshExpirationTime time.Duration `koanf:"refresh_expiration_time"`
 	AccessSubject         string        `koanf:"access_subject"`
 	RefreshSubject        string        `koanf:"refresh_subject"`
 }
 
+// Repository keeps the ids of the refresh tokens that haven't been used yet,
+// and the revoked tokens until they expire.
+type Repository interface {
+	StoreRefreshToken(ctx context.Context, tokenID string, userID uint, expTime time.Duration) error
+	// DeleteRefreshToken returns false if the token has already been used or revoked
+	DeleteRefreshToken(ctx context.Context, tokenID string) (bool, error)
+	RevokeToken(ctx context.Context, tokenID string, expTime time.Duration) error
+	SetTokensValidAfter(ctx context.Context, userID uint, unixMilli int64, expTime time.Duration) error
+	GetTokenRevocation(ctx context.Context, tokenID string, userID uint) (bool, int64, error)
+}
+
 type Service struct {
 	config Config
+	repo   Repository
+	keys   keySet
 }
 
-func New(cfg Config) Service {
+func New(cfg Config, repo Repository) Service {
+	keys, err := loadKeys(cfg)
+	if err != nil {
+		panic(fmt.Errorf("can't load auth keys: %v", err))
+	}
+
 	return Service{
 		config: cfg,
+		repo:   repo,
+		keys:   keys,
 	}
 }
 
 func (s Service) CreateAccessToken(user entity.User) (string, error) {
-	return s.createToken(user.ID, user.Role, s.config.AccessSubject, s.config.AccessExpirationTime)
+	token, _, err := s.createToken(user.ID, user.Role, s.config.AccessSubject, s.config.AccessExpirationTime)
+
+	return token, err
 }
 
+// CreateRefreshToken issues a one-time refresh token, it can be used once in ConsumeRefreshToken.
 func (s Service) CreateRefreshToken(user entity.User) (string, error) {
-	return s.createToken(user.ID, user.Role, s.config.RefreshSubject, s.config.RefreshExpirationTime)
+	token, tokenID, err := s.createToken(user.ID, user.Role, s.config.RefreshSubject, s.config.RefreshExpirationTime)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.repo.StoreRefreshToken(context.Background(), tokenID, user.ID,
+		s.config.RefreshExpirationTime); err != nil {
+		return "", err
+	}
+
+	return token, nil
 }
 
 func (s Service) ParseToken(bearerToken string) (*Claims, error) {
@@ -38,9 +83,7 @@ func (s Service) ParseToken(bearerToken string) (*Claims, error) {
 
 	tokenStr := strings.Replace(bearerToken, "Bearer ", "", 1)
 
-	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.config.SignKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, s.keys.verifyKey)
 	if err != nil {
 		return nil, err
 	}
@@ -52,26 +95,160 @@ func (s Service) ParseToken(bearerToken string) (*Claims, error) {
 	}
 }
 
-func (s Service) createToken(userID uint, role entity.Role, subject string, expireDuration time.Duration) (string, error) {
-	// create a signer for rsa 256
-	// TODO - replace with rsa 256 RS256 - https://github.com/golang-jwt/jwt/blob/main/http_example_test.go
+// ParseAccessToken rejects valid tokens that are not issued as access tokens, e.g. refresh tokens.
+func (s Service) ParseAccessToken(bearerToken string) (*Claims, error) {
+	return s.parseTokenWithSubject(bearerToken, s.config.AccessSubject)
+}
+
+func (s Service) ParseRefreshToken(token string) (*Claims, error) {
+	return s.parseTokenWithSubject(token, s.config.RefreshSubject)
+}
+
+// ConsumeRefreshToken marks the refresh token as used, a refresh token can't be consumed twice.
+func (s Service) ConsumeRefreshToken(ctx context.Context, claims *Claims) error {
+	const op = "authservice.ConsumeRefreshToken"
+
+	isRevoked, err := s.IsTokenRevoked(ctx, claims)
+	if err != nil {
+		return richerror.New(op).WithErr(err)
+	}
+
+	if isRevoked {
+		return richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidToken).WithKind(richerror.KindUnauthorized).
+			WithMeta(map[string]interface{}{"user_id": claims.UserID})
+	}
+
+	deleted, err := s.repo.DeleteRefreshToken(ctx, claims.ID)
+	if err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	if !deleted {
+		return richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidToken).WithKind(richerror.KindUnauthorized).
+			WithMeta(map[string]interface{}{"user_id": claims.UserID})
+	}
+
+	return nil
+}
+
+// RevokeToken denies the token until it expires, refresh tokens are deleted instead.
+func (s Service) RevokeToken(ctx context.Context, claims *Claims) error {
+	const op = "authservice.RevokeToken"
+
+	if claims.Subject == s.config.RefreshSubject {
+		if _, err := s.repo.DeleteRefreshToken(ctx, claims.ID); err != nil {
+			return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+		}
+
+		return nil
+	}
+
+	ttl := time.Until(claims.ExpiresAt.Time)
+	if ttl <= 0 {
+		return nil
+	}
+
+	if err := s.repo.RevokeToken(ctx, claims.ID, ttl); err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// RevokeAllUserTokens denies every token of the user that is issued until now.
+func (s Service) RevokeAllUserTokens(ctx context.Context, userID uint) error {
+	const op = "authservice.RevokeAllUserTokens"
+
+	// no token that is issued before now lives longer than a refresh token
+	if err := s.repo.SetTokensValidAfter(ctx, userID, time.Now().UnixMilli(),
+		s.config.RefreshExpirationTime); err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return nil
+}
+
+// IsTokenRevoked checks the deny list and the user's "tokens valid after" watermark.
+func (s Service) IsTokenRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	const op = "authservice.IsTokenRevoked"
+
+	isRevoked, validAfter, err := s.repo.GetTokenRevocation(ctx, claims.ID, claims.UserID)
+	if err != nil {
+		return false, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	if isRevoked {
+		return true, nil
+	}
+
+	// tokens without iat are issued before the revocation support, treat them as issued at the beginning.
+	// tokens without iat_ms are issued before it was added, treat them as issued at the end of their iat second
+	var issuedAt int64
+	switch {
+	case claims.IssuedAtMilli != 0:
+		issuedAt = claims.IssuedAtMilli
+	case claims.IssuedAt != nil:
+		issuedAt = claims.IssuedAt.Unix()*1000 + 999
+	}
+
+	return validAfter != 0 && issuedAt <= validAfter, nil
+}
+
+// JWKS returns the public keys that verify the tokens, it's empty for HS256.
+func (s Service) JWKS(_ param.JWKSRequest) (param.JWKSResponse, error) {
+	keys := make([]param.JWK, 0, len(s.keys.keyIDs))
+	for _, kid := range s.keys.keyIDs {
+		if k, ok := jwkOf(kid, s.keys.method.Alg(), s.keys.verifyKeys[kid]); ok {
+			keys = append(keys, k)
+		}
+	}
+
+	return param.JWKSResponse{Keys: keys}, nil
+}
+
+func (s Service) parseTokenWithSubject(token, subject string) (*Claims, error) {
+	const op = "authservice.parseTokenWithSubject"
+
+	claims, err := s.ParseToken(token)
+	if err != nil {
+		return nil, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgInvalidToken).WithKind(richerror.KindUnauthorized)
+	}
+
+	if claims.Subject != subject {
+		return nil, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidToken).WithKind(richerror.KindUnauthorized)
+	}
+
+	return claims, nil
+}
+
+func (s Service) createToken(userID uint, role entity.Role, subject string, expireDuration time.Duration) (string, string, error) {
+	tokenID := uuid.NewString()
+
+	now := time.Now()
 
 	// set our claims
 	claims := Claims{
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
 			Subject:   subject,
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expireDuration)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expireDuration)),
 		},
-		UserID: userID,
-		Role:   role,
+		UserID:        userID,
+		Role:          role,
+		IssuedAtMilli: now.UnixMilli(),
 	}
 
-	// TODO - add sign method to config
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := accessToken.SignedString([]byte(s.config.SignKey))
+	accessToken := jwt.NewWithClaims(s.keys.method, claims)
+	if s.keys.signKeyID != "" {
+		accessToken.Header["kid"] = s.keys.signKeyID
+	}
+
+	tokenString, err := accessToken.SignedString(s.keys.signKey)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
-	return tokenString, nil
-}
\ No newline at end of file
+	return tokenString, tokenID, nil
+}
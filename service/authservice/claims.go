@@ -9,6 +9,9 @@ type Claims struct {
 	jwt.RegisteredClaims
 	UserID uint        `json:"user_id"`
 	Role   entity.Role `json:"role"`
+	// IssuedAtMilli is iat in milliseconds, iat only has second precision
+	// and a token issued right after a "revoke all" must not be revoked too
+	IssuedAtMilli int64 `json:"iat_ms,omitempty"`
 }
 
 func (c Claims) Valid() error {
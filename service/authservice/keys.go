@@ -0,0 +1,188 @@
+package authservice
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"gameAppProject/param"
+	"github.com/golang-jwt/jwt/v4"
+	"math/big"
+	"os"
+)
+
+const (
+	SignMethodHS256 = "HS256"
+	SignMethodRS256 = "RS256"
+	SignMethodEdDSA = "EdDSA"
+)
+
+// KeyConfig is a PEM encoded key pair, keys without a private key are only used to verify tokens,
+// e.g. the previous key while rotating.
+type KeyConfig struct {
+	ID             string `koanf:"id"`
+	PrivateKeyPath string `koanf:"private_key_path"`
+	PublicKeyPath  string `koanf:"public_key_path"`
+}
+
+type keySet struct {
+	method     jwt.SigningMethod
+	signKeyID  string
+	signKey    interface{}
+	verifyKeys map[string]crypto.PublicKey
+	// keyIDs keeps the order of the config for the jwks response
+	keyIDs []string
+}
+
+func loadKeys(cfg Config) (keySet, error) {
+	if cfg.SignMethod == "" || cfg.SignMethod == SignMethodHS256 {
+		return keySet{method: jwt.SigningMethodHS256, signKey: []byte(cfg.SignKey)}, nil
+	}
+
+	set := keySet{
+		signKeyID:  cfg.SignKeyID,
+		verifyKeys: make(map[string]crypto.PublicKey, len(cfg.Keys)),
+	}
+
+	switch cfg.SignMethod {
+	case SignMethodRS256:
+		set.method = jwt.SigningMethodRS256
+	case SignMethodEdDSA:
+		set.method = jwt.SigningMethodEdDSA
+	default:
+		return keySet{}, fmt.Errorf("sign method %s is not supported", cfg.SignMethod)
+	}
+
+	for _, k := range cfg.Keys {
+		if _, ok := set.verifyKeys[k.ID]; ok {
+			return keySet{}, fmt.Errorf("key %s is duplicated", k.ID)
+		}
+
+		var (
+			privateKey crypto.PrivateKey
+			publicKey  crypto.PublicKey
+			err        error
+		)
+
+		if k.PrivateKeyPath != "" {
+			privateKey, publicKey, err = loadPrivateKey(cfg.SignMethod, k.PrivateKeyPath)
+			if err != nil {
+				return keySet{}, fmt.Errorf("can't load private key %s: %w", k.ID, err)
+			}
+		}
+
+		if k.PublicKeyPath != "" {
+			publicKey, err = loadPublicKey(cfg.SignMethod, k.PublicKeyPath)
+			if err != nil {
+				return keySet{}, fmt.Errorf("can't load public key %s: %w", k.ID, err)
+			}
+		}
+
+		if publicKey == nil {
+			return keySet{}, fmt.Errorf("key %s has neither a private nor a public key", k.ID)
+		}
+
+		if k.ID == cfg.SignKeyID {
+			if privateKey == nil {
+				return keySet{}, fmt.Errorf("sign key %s has no private key", k.ID)
+			}
+
+			set.signKey = privateKey
+		}
+
+		set.verifyKeys[k.ID] = publicKey
+		set.keyIDs = append(set.keyIDs, k.ID)
+	}
+
+	if set.signKey == nil {
+		return keySet{}, fmt.Errorf("sign key %s is not found", cfg.SignKeyID)
+	}
+
+	return set, nil
+}
+
+// verifyKey returns the key of the token, the algorithm is checked
+// so a token can't be verified with a key of another algorithm.
+func (k keySet) verifyKey(token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() != k.method.Alg() {
+		return nil, fmt.Errorf("unexpected sign method %s", token.Method.Alg())
+	}
+
+	if k.verifyKeys == nil {
+		return k.signKey, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := k.verifyKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("key %s is not found", kid)
+	}
+
+	return key, nil
+}
+
+func loadPrivateKey(method, path string) (crypto.PrivateKey, crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if method == SignMethodRS256 {
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return key, &key.PublicKey, nil
+	}
+
+	key, err := jwt.ParseEdPrivateKeyFromPEM(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, key.(ed25519.PrivateKey).Public(), nil
+}
+
+func loadPublicKey(method, path string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if method == SignMethodRS256 {
+		return jwt.ParseRSAPublicKeyFromPEM(data)
+	}
+
+	return jwt.ParseEdPublicKeyFromPEM(data)
+}
+
+func encodeBase64URL(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func jwkOf(kid, alg string, key crypto.PublicKey) (param.JWK, bool) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return param.JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: alg,
+			N:   encodeBase64URL(k.N.Bytes()),
+			E:   encodeBase64URL(big.NewInt(int64(k.E)).Bytes()),
+		}, true
+	case ed25519.PublicKey:
+		return param.JWK{
+			Kty: "OKP",
+			Kid: kid,
+			Use: "sig",
+			Alg: alg,
+			Crv: "Ed25519",
+			X:   encodeBase64URL(k),
+		}, true
+	default:
+		return param.JWK{}, false
+	}
+}
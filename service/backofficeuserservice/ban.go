@@ -0,0 +1,63 @@
+package backofficeuserservice
+
+import (
+	"context"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+// BanUser keeps the user from logging in and logs out all their sessions.
+func (s Service) BanUser(ctx context.Context, req param.BanUserRequest) (param.BanUserResponse, error) {
+	const op = "backofficeuserservice.BanUser"
+
+	if err := checkActor(op, req.ActorID, req.UserID); err != nil {
+		return param.BanUserResponse{}, err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return param.BanUserResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if err := checkTarget(op, req.ActorRole, user); err != nil {
+		return param.BanUserResponse{}, err
+	}
+
+	if err := s.repo.SetBanned(ctx, req.UserID, true); err != nil {
+		return param.BanUserResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if err := s.authClient.RevokeAllUserTokens(ctx, req.UserID); err != nil {
+		return param.BanUserResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	return param.BanUserResponse{}, nil
+}
+
+func (s Service) UnbanUser(ctx context.Context, req param.UnbanUserRequest) (param.UnbanUserResponse, error) {
+	const op = "backofficeuserservice.UnbanUser"
+
+	if err := checkActor(op, req.ActorID, req.UserID); err != nil {
+		return param.UnbanUserResponse{}, err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return param.UnbanUserResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if err := checkTarget(op, req.ActorRole, user); err != nil {
+		return param.UnbanUserResponse{}, err
+	}
+
+	if err := s.repo.SetBanned(ctx, req.UserID, false); err != nil {
+		return param.UnbanUserResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	return param.UnbanUserResponse{}, nil
+}
@@ -0,0 +1,38 @@
+package backofficeuserservice
+
+import (
+	"context"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+// DeleteUser soft deletes the user and logs out all their sessions.
+func (s Service) DeleteUser(ctx context.Context, req param.BackofficeUserDeleteRequest) (param.BackofficeUserDeleteResponse, error) {
+	const op = "backofficeuserservice.DeleteUser"
+
+	if err := checkActor(op, req.ActorID, req.UserID); err != nil {
+		return param.BackofficeUserDeleteResponse{}, err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return param.BackofficeUserDeleteResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if err := checkTarget(op, req.ActorRole, user); err != nil {
+		return param.BackofficeUserDeleteResponse{}, err
+	}
+
+	if err := s.repo.DeleteUser(ctx, req.UserID); err != nil {
+		return param.BackofficeUserDeleteResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if err := s.authClient.RevokeAllUserTokens(ctx, req.UserID); err != nil {
+		return param.BackofficeUserDeleteResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	return param.BackofficeUserDeleteResponse{}, nil
+}
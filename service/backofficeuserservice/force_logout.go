@@ -0,0 +1,33 @@
+package backofficeuserservice
+
+import (
+	"context"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+// ForceLogout revokes all sessions of a user, e.g. when the account is compromised.
+func (s Service) ForceLogout(ctx context.Context, req param.ForceLogoutRequest) (param.ForceLogoutResponse, error) {
+	const op = "backofficeuserservice.ForceLogout"
+
+	if err := checkActor(op, req.ActorID, req.UserID); err != nil {
+		return param.ForceLogoutResponse{}, err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return param.ForceLogoutResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if err := checkTarget(op, req.ActorRole, user); err != nil {
+		return param.ForceLogoutResponse{}, err
+	}
+
+	if err := s.authClient.RevokeAllUserTokens(ctx, req.UserID); err != nil {
+		return param.ForceLogoutResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	return param.ForceLogoutResponse{}, nil
+}
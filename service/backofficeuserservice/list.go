@@ -0,0 +1,57 @@
+package backofficeuserservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+func (s Service) ListUsers(ctx context.Context, req param.BackofficeUserListRequest) (param.BackofficeUserListResponse, error) {
+	const op = "backofficeuserservice.ListUsers"
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	page := req.Page
+	if page == 0 {
+		page = 1
+	}
+
+	filter := entity.UserFilter{
+		Role:        entity.MapToRoleEntity(req.Role),
+		PhoneNumber: req.PhoneNumber,
+		Name:        req.Name,
+	}
+
+	sort := entity.UserSort{
+		Field:      entity.UserSortField(req.SortBy),
+		Descending: req.SortOrder == "desc",
+	}
+
+	users, total, err := s.repo.ListUsers(ctx, filter, sort, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return param.BackofficeUserListResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	infos := make([]param.BackofficeUserInfo, 0, len(users))
+	for _, u := range users {
+		infos = append(infos, toBackofficeUserInfo(u))
+	}
+
+	return param.BackofficeUserListResponse{Users: infos, Total: total}, nil
+}
+
+func (s Service) GetUser(ctx context.Context, req param.BackofficeUserDetailRequest) (param.BackofficeUserDetailResponse, error) {
+	const op = "backofficeuserservice.GetUser"
+
+	user, err := s.repo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return param.BackofficeUserDetailResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	return param.BackofficeUserDetailResponse{User: toBackofficeUserInfo(user)}, nil
+}
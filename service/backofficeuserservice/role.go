@@ -0,0 +1,73 @@
+package backofficeuserservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+)
+
+// ChangeRole logs out all sessions of the user, the role is in the tokens.
+// The actor can't change a higher role or give a role higher than their own,
+// giving or taking the admin roles needs the admin role management permission too.
+func (s Service) ChangeRole(ctx context.Context, req param.ChangeRoleRequest) (param.ChangeRoleResponse, error) {
+	const op = "backofficeuserservice.ChangeRole"
+
+	if err := checkActor(op, req.ActorID, req.UserID); err != nil {
+		return param.ChangeRoleResponse{}, err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return param.ChangeRoleResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	role := entity.MapToRoleEntity(req.Role)
+	if user.Role == role {
+		return param.ChangeRoleResponse{User: toBackofficeUserInfo(user)}, nil
+	}
+
+	if err := s.checkRoleChange(ctx, req.ActorID, req.ActorRole, user.Role, role); err != nil {
+		return param.ChangeRoleResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if err := s.repo.UpdateRole(ctx, req.UserID, role); err != nil {
+		return param.ChangeRoleResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if err := s.authClient.RevokeAllUserTokens(ctx, req.UserID); err != nil {
+		return param.ChangeRoleResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	user.Role = role
+
+	return param.ChangeRoleResponse{User: toBackofficeUserInfo(user)}, nil
+}
+
+func (s Service) checkRoleChange(ctx context.Context, actorID uint, actorRole, from, to entity.Role) error {
+	const op = "backofficeuserservice.checkRoleChange"
+
+	if from.IsHigherThan(actorRole) || to.IsHigherThan(actorRole) {
+		return richerror.New(op).WithMessage(errmsg.ErrorMsgUserNotAllowed).WithKind(richerror.KindForbidden)
+	}
+
+	if !from.IsHigherThan(entity.UserRole) && !to.IsHigherThan(entity.UserRole) {
+		return nil
+	}
+
+	allowed, err := s.accessChecker.CheckAccess(ctx, actorID, actorRole, entity.AdminRoleManagePermission)
+	if err != nil {
+		return richerror.New(op).WithErr(err)
+	}
+
+	if !allowed {
+		return richerror.New(op).WithMessage(errmsg.ErrorMsgUserNotAllowed).WithKind(richerror.KindForbidden)
+	}
+
+	return nil
+}
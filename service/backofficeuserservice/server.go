@@ -1,24 +1,83 @@
 package backofficeuserservice
 
-import "gameAppProject/entity"
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"time"
+)
 
-type Service struct{}
+const defaultPageSize = 10
 
-func New() Service {
-	return Service{}
+type Repository interface {
+	ListUsers(ctx context.Context, filter entity.UserFilter, sort entity.UserSort,
+		offset, limit uint) ([]entity.User, uint, error)
+	GetUserByID(ctx context.Context, userID uint) (entity.User, error)
+	DeleteUser(ctx context.Context, userID uint) error
+	SetBanned(ctx context.Context, userID uint, banned bool) error
+	UpdateRole(ctx context.Context, userID uint, role entity.Role) error
 }
 
-func (s Service) ListAllUsers() ([]entity.User, error) {
-	// TODO - implement me
-	list := make([]entity.User, 0)
+type AuthClient interface {
+	RevokeAllUserTokens(ctx context.Context, userID uint) error
+}
+
+type AccessChecker interface {
+	CheckAccess(ctx context.Context, userID uint, role entity.Role, permissions ...entity.PermissionTitle) (bool, error)
+}
+
+type Service struct {
+	repo          Repository
+	authClient    AuthClient
+	accessChecker AccessChecker
+}
+
+func New(repo Repository, authClient AuthClient, accessChecker AccessChecker) Service {
+	return Service{repo: repo, authClient: authClient, accessChecker: accessChecker}
+}
+
+// checkActor rejects the actions of an admin on their own account, e.g. banning themselves.
+func checkActor(op string, actorID, userID uint) error {
+	if actorID == userID {
+		return richerror.New(richerror.Op(op)).WithMessage(errmsg.ErrorMsgUserNotAllowed).
+			WithKind(richerror.KindForbidden).WithMeta(map[string]interface{}{"user_id": userID})
+	}
+
+	return nil
+}
+
+// checkTarget rejects the actions of an admin on a user with the same or a higher role.
+func checkTarget(op string, actorRole entity.Role, target entity.User) error {
+	if !actorRole.IsHigherThan(target.Role) {
+		return richerror.New(richerror.Op(op)).WithMessage(errmsg.ErrorMsgUserNotAllowed).
+			WithKind(richerror.KindForbidden).WithMeta(map[string]interface{}{"user_id": target.ID})
+	}
+
+	return nil
+}
+
+func toBackofficeUserInfo(u entity.User) param.BackofficeUserInfo {
+	info := param.BackofficeUserInfo{
+		ID:          u.ID,
+		Name:        u.Name,
+		PhoneNumber: u.PhoneNumber,
+		Role:        u.Role.String(),
+		CreatedAt:   u.CreatedAt,
+	}
 
-	list = append(list, entity.User{
-		ID:          0,
-		PhoneNumber: "fake",
-		Name:        "fake",
-		Password:    "fake",
-		Role:        entity.AdminRole,
-	})
+	if u.IsVerified() {
+		info.VerifiedAt = timePtr(u.VerifiedAt)
+	}
 
-	return list, nil
-}
\ No newline at end of file
+	if u.IsBanned() {
+		info.BannedAt = timePtr(u.BannedAt)
+	}
+
+	return info
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
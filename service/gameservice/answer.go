@@ -0,0 +1,169 @@
+package gameservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"gameAppProject/pkg/slice"
+)
+
+func (s Service) AnswerQuestion(ctx context.Context, req param.AnswerQuestionRequest) (param.AnswerQuestionResponse, error) {
+	const op = richerror.Op("gameservice.AnswerQuestion")
+
+	game, err := s.repo.GetGameByID(ctx, req.GameID)
+	if err != nil {
+		return param.AnswerQuestionResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"req": req})
+	}
+
+	if game.IsFinished() {
+		return param.AnswerQuestionResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgGameIsFinished).
+			WithKind(richerror.KindInvalid).WithMeta(map[string]interface{}{"req": req})
+	}
+
+	if !slice.DoesExist(game.QuestionIDs, req.QuestionID) {
+		return param.AnswerQuestionResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgQuestionIsNotInGame).
+			WithKind(richerror.KindInvalid).WithMeta(map[string]interface{}{"req": req})
+	}
+
+	players, err := s.repo.GetPlayersByGameID(ctx, game.ID)
+	if err != nil {
+		return param.AnswerQuestionResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	player, ok := findPlayerByUserID(players, req.UserID)
+	if !ok {
+		return param.AnswerQuestionResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgUserIsNotInGame).
+			WithKind(richerror.KindForbidden).WithMeta(map[string]interface{}{"req": req})
+	}
+
+	for _, a := range player.Answers {
+		if a.QuestionID == req.QuestionID {
+			return param.AnswerQuestionResponse{}, richerror.New(op).
+				WithMessage(errmsg.ErrorMsgQuestionAlreadyAnswered).
+				WithKind(richerror.KindInvalid).WithMeta(map[string]interface{}{"req": req})
+		}
+	}
+
+	question, err := s.questionRepo.GetQuestionByID(ctx, req.QuestionID)
+	if err != nil {
+		return param.AnswerQuestionResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	answer := entity.PlayerAnswer{
+		PlayerID:   player.ID,
+		QuestionID: req.QuestionID,
+		Choice:     req.Choice,
+		IsCorrect:  question.CorrectAnswer == req.Choice,
+	}
+
+	// the unique key on player and question guards concurrent submissions of the same answer
+	score, err := s.repo.CreatePlayerAnswer(ctx, answer)
+	if err != nil {
+		return param.AnswerQuestionResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	resp := param.AnswerQuestionResponse{IsCorrect: answer.IsCorrect, Score: score}
+
+	// reload players to see answers of the other players that are submitted meanwhile
+	players, err = s.repo.GetPlayersByGameID(ctx, game.ID)
+	if err != nil {
+		return param.AnswerQuestionResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	s.notifier.Notify(opponentsOf(userIDsOf(players), req.UserID), param.GameEvent{
+		Type: param.GameEventOpponentAnswered,
+		Payload: param.OpponentAnsweredEventPayload{
+			GameID:     game.ID,
+			QuestionID: req.QuestionID,
+			UserID:     req.UserID,
+		},
+	})
+
+	if !haveAllPlayersAnswered(players, len(game.QuestionIDs)) {
+		player, _ = findPlayerByUserID(players, req.UserID)
+		if questionID, ok := nextQuestionID(game.QuestionIDs, player); ok {
+			s.notifyQuestion(ctx, []uint{req.UserID}, game.ID, questionID)
+		}
+
+		return resp, nil
+	}
+
+	winner, hasWinner := determineWinner(players)
+
+	var winnerID uint
+	if hasWinner {
+		winnerID = winner.ID
+		resp.WinnerUserID = winner.UserID
+	}
+
+	// the game may be finished by the other player's last answer, the result is the same.
+	// the game finished event is saved only by the call that finishes the game, the ratings are updated by consuming it
+	_, err = s.repo.FinishGame(ctx, game.ID, winnerID)
+	if err != nil {
+		return param.AnswerQuestionResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	resp.IsGameFinished = true
+
+	scores := make([]param.PlayerScore, 0, len(players))
+	for _, p := range players {
+		scores = append(scores, param.PlayerScore{UserID: p.UserID, Score: p.Score})
+	}
+
+	s.notifier.Notify(userIDsOf(players), param.GameEvent{
+		Type: param.GameEventGameFinished,
+		Payload: param.GameFinishedEventPayload{
+			GameID:       game.ID,
+			WinnerUserID: resp.WinnerUserID,
+			Scores:       scores,
+		},
+	})
+
+	return resp, nil
+}
+
+func findPlayerByUserID(players []entity.Player, userID uint) (entity.Player, bool) {
+	for _, p := range players {
+		if p.UserID == userID {
+			return p, true
+		}
+	}
+
+	return entity.Player{}, false
+}
+
+func haveAllPlayersAnswered(players []entity.Player, questionCount int) bool {
+	for _, p := range players {
+		if len(p.Answers) < questionCount {
+			return false
+		}
+	}
+
+	return true
+}
+
+// determineWinner returns the player with the most correct answers,
+// there is no winner if more than one player has the highest score.
+func determineWinner(players []entity.Player) (entity.Player, bool) {
+	var winner entity.Player
+	isDraw := false
+
+	for _, p := range players {
+		switch {
+		case p.Score > winner.Score:
+			winner = p
+			isDraw = false
+		case p.Score == winner.Score:
+			isDraw = true
+		}
+	}
+
+	if isDraw || winner.ID == 0 {
+		return entity.Player{}, false
+	}
+
+	return winner, true
+}
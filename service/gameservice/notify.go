@@ -0,0 +1,70 @@
+package gameservice
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+)
+
+func (s Service) notifyQuestion(ctx context.Context, userIDs []uint, gameID, questionID uint) {
+	question, err := s.questionRepo.GetQuestionByID(ctx, questionID)
+	if err != nil {
+		// TODO - log error
+		fmt.Println("questionRepo.GetQuestionByID error", err)
+
+		return
+	}
+
+	answers := make([]param.PossibleAnswerInfo, 0, len(question.PossibleAnswers))
+	for _, a := range question.PossibleAnswers {
+		answers = append(answers, param.PossibleAnswerInfo{Text: a.Text, Choice: a.Choice})
+	}
+
+	s.notifier.Notify(userIDs, param.GameEvent{
+		Type: param.GameEventQuestion,
+		Payload: param.QuestionEventPayload{
+			GameID:          gameID,
+			QuestionID:      question.ID,
+			Text:            question.Text,
+			PossibleAnswers: answers,
+			Difficulty:      question.Difficulty,
+		},
+	})
+}
+
+// nextQuestionID returns the first question of the game that the player hasn't answered yet.
+func nextQuestionID(questionIDs []uint, player entity.Player) (uint, bool) {
+	answered := make(map[uint]bool, len(player.Answers))
+	for _, a := range player.Answers {
+		answered[a.QuestionID] = true
+	}
+
+	for _, id := range questionIDs {
+		if !answered[id] {
+			return id, true
+		}
+	}
+
+	return 0, false
+}
+
+func opponentsOf(userIDs []uint, userID uint) []uint {
+	opponents := make([]uint, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != userID {
+			opponents = append(opponents, id)
+		}
+	}
+
+	return opponents
+}
+
+func userIDsOf(players []entity.Player) []uint {
+	userIDs := make([]uint, 0, len(players))
+	for _, p := range players {
+		userIDs = append(userIDs, p.UserID)
+	}
+
+	return userIDs
+}
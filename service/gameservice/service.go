@@ -0,0 +1,50 @@
+package gameservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+)
+
+type Repository interface {
+	CreateGame(ctx context.Context, game entity.Game, userIDs []uint) (entity.Game, error)
+	GetGameByID(ctx context.Context, gameID uint) (entity.Game, error)
+	GetPlayersByGameID(ctx context.Context, gameID uint) ([]entity.Player, error)
+	CreatePlayerAnswer(ctx context.Context, answer entity.PlayerAnswer) (uint, error)
+	FinishGame(ctx context.Context, gameID uint, winnerID uint) (bool, error)
+	GetUserStats(ctx context.Context, userID uint) ([]entity.CategoryStats, error)
+}
+
+type QuestionRepository interface {
+	GetRandomQuestionIDs(ctx context.Context, category entity.Category, count int) ([]uint, error)
+	GetQuestionByID(ctx context.Context, questionID uint) (entity.Question, error)
+}
+
+// MatchingClient owns the waiting lists, the matched users are claimed from it before their game is created.
+type MatchingClient interface {
+	ClaimMatchedUsers(ctx context.Context, req param.ClaimMatchedUsersRequest) (param.ClaimMatchedUsersResponse, error)
+	RestoreMatchedUsers(ctx context.Context, req param.RestoreMatchedUsersRequest) (param.RestoreMatchedUsersResponse, error)
+}
+
+// Notifier pushes game events to the connected players, delivery is best effort.
+type Notifier interface {
+	Notify(userIDs []uint, event param.GameEvent)
+}
+
+type Config struct {
+	QuestionCount int `koanf:"question_count"`
+}
+
+type Service struct {
+	config         Config
+	repo           Repository
+	questionRepo   QuestionRepository
+	matchingClient MatchingClient
+	notifier       Notifier
+}
+
+func New(config Config, repo Repository, questionRepo QuestionRepository,
+	matchingClient MatchingClient, notifier Notifier) Service {
+	return Service{config: config, repo: repo, questionRepo: questionRepo, matchingClient: matchingClient,
+		notifier: notifier}
+}
@@ -0,0 +1,74 @@
+package gameservice
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"time"
+)
+
+func (s Service) StartGame(ctx context.Context, req param.StartGameRequest) (param.StartGameResponse, error) {
+	const op = richerror.Op("gameservice.StartGame")
+
+	questionIDs, err := s.questionRepo.GetRandomQuestionIDs(ctx, req.Category, s.config.QuestionCount)
+	if err != nil {
+		return param.StartGameResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if len(questionIDs) == 0 {
+		return param.StartGameResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgNoQuestionForCategory).
+			WithKind(richerror.KindNotFound).WithMeta(map[string]interface{}{"category": req.Category})
+	}
+
+	// users are claimed from the waiting list before creating the game,
+	// if another round has already claimed any of them the game is not created
+	claim, err := s.matchingClient.ClaimMatchedUsers(ctx, param.ClaimMatchedUsersRequest{
+		Category: req.Category,
+		UserIDs:  req.UserIDs,
+	})
+	if err != nil {
+		return param.StartGameResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if !claim.Claimed {
+		return param.StartGameResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgUsersAreNotWaiting).
+			WithKind(richerror.KindInvalid).WithMeta(map[string]interface{}{"req": req})
+	}
+
+	game, err := s.repo.CreateGame(ctx, entity.Game{
+		Category:    req.Category,
+		QuestionIDs: questionIDs,
+		StartTime:   time.Now(),
+	}, req.UserIDs)
+	if err != nil {
+		// put users back to the waiting list, so they can be matched in the next round
+		if _, rErr := s.matchingClient.RestoreMatchedUsers(ctx, param.RestoreMatchedUsersRequest{
+			Category: req.Category,
+			Users:    claim.Users,
+		}); rErr != nil {
+			// TODO - log error
+			fmt.Println("matchingClient.RestoreMatchedUsers error", rErr)
+		}
+
+		return param.StartGameResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	for _, userID := range req.UserIDs {
+		s.notifier.Notify([]uint{userID}, param.GameEvent{
+			Type: param.GameEventMatched,
+			Payload: param.MatchedEventPayload{
+				GameID:          game.ID,
+				Category:        game.Category,
+				OpponentUserIDs: opponentsOf(req.UserIDs, userID),
+				QuestionCount:   len(game.QuestionIDs),
+			},
+		})
+	}
+
+	s.notifyQuestion(ctx, req.UserIDs, game.ID, game.QuestionIDs[0])
+
+	return param.StartGameResponse{GameID: game.ID, PlayerIDs: game.PlayerIDs}, nil
+}
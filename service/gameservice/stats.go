@@ -0,0 +1,29 @@
+package gameservice
+
+import (
+	"context"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+func (s Service) GetUserStats(ctx context.Context, req param.GetUserStatsRequest) (param.GetUserStatsResponse, error) {
+	const op = "gameservice.GetUserStats"
+
+	stats, err := s.repo.GetUserStats(ctx, req.UserID)
+	if err != nil {
+		return param.GetUserStatsResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	items := make([]param.CategoryStats, 0, len(stats))
+	for _, st := range stats {
+		items = append(items, param.CategoryStats{
+			Category:    string(st.Category),
+			GamesPlayed: st.GamesPlayed,
+			Wins:        st.Wins,
+			Accuracy:    st.Accuracy(),
+		})
+	}
+
+	return param.GetUserStatsResponse{Items: items}, nil
+}
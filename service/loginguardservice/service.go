@@ -0,0 +1,141 @@
+package loginguardservice
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+	"time"
+)
+
+type Config struct {
+	MaxFailedAttemptsPerPhoneNumber int64         `koanf:"max_failed_attempts_per_phone_number"`
+	MaxFailedAttemptsPerIP          int64         `koanf:"max_failed_attempts_per_ip"`
+	FailedAttemptsWindow            time.Duration `koanf:"failed_attempts_window"`
+	// the lockout doubles on every failure after the max attempts, up to MaxLockoutDuration
+	LockoutDuration    time.Duration `koanf:"lockout_duration"`
+	MaxLockoutDuration time.Duration `koanf:"max_lockout_duration"`
+	Prefix             string        `koanf:"prefix"`
+}
+
+type Repository interface {
+	IncrementFailedAttempts(ctx context.Context, key string, window time.Duration) (int64, error)
+	ResetFailedAttempts(ctx context.Context, key string) error
+	Lock(ctx context.Context, key string, duration time.Duration) error
+	GetLockTTLs(ctx context.Context, keys []string) ([]time.Duration, error)
+}
+
+type AuditRepository interface {
+	CreateAuditLog(ctx context.Context, log entity.AuditLog) (entity.AuditLog, error)
+}
+
+type Service struct {
+	config    Config
+	repo      Repository
+	auditRepo AuditRepository
+}
+
+func New(config Config, repo Repository, auditRepo AuditRepository) Service {
+	return Service{config: config, repo: repo, auditRepo: auditRepo}
+}
+
+// CheckLogin returns the remaining lockout of the phone number or the ip, whichever is longer.
+func (s Service) CheckLogin(ctx context.Context, req param.CheckLoginRequest) (param.CheckLoginResponse, error) {
+	const op = "loginguardservice.CheckLogin"
+
+	ttls, err := s.repo.GetLockTTLs(ctx, []string{
+		s.lockKey("phone_number", req.PhoneNumber),
+		s.lockKey("ip", req.IP),
+	})
+	if err != nil {
+		return param.CheckLoginResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	var retryAfter time.Duration
+	for _, ttl := range ttls {
+		retryAfter = max(retryAfter, ttl)
+	}
+
+	return param.CheckLoginResponse{RetryAfter: retryAfter}, nil
+}
+
+// LoginFailed counts the failure for the phone number and the ip, the phone number doesn't need to be registered,
+// so the lockout doesn't tell whether a phone number is registered.
+func (s Service) LoginFailed(ctx context.Context, req param.LoginFailedRequest) (param.LoginFailedResponse, error) {
+	const op = "loginguardservice.LoginFailed"
+
+	targets := []struct {
+		kind        string
+		value       string
+		maxAttempts int64
+	}{
+		{kind: "phone_number", value: req.PhoneNumber, maxAttempts: s.config.MaxFailedAttemptsPerPhoneNumber},
+		{kind: "ip", value: req.IP, maxAttempts: s.config.MaxFailedAttemptsPerIP},
+	}
+
+	// the failures are kept during the longest lockout too, otherwise the lockout wouldn't grow
+	window := s.config.FailedAttemptsWindow + s.config.MaxLockoutDuration
+
+	for _, t := range targets {
+		count, err := s.repo.IncrementFailedAttempts(ctx, s.attemptsKey(t.kind, t.value), window)
+		if err != nil {
+			return param.LoginFailedResponse{}, richerror.New(op).WithErr(err)
+		}
+
+		if count < t.maxAttempts {
+			continue
+		}
+
+		duration := s.lockoutDuration(count - t.maxAttempts)
+		if err := s.repo.Lock(ctx, s.lockKey(t.kind, t.value), duration); err != nil {
+			return param.LoginFailedResponse{}, richerror.New(op).WithErr(err)
+		}
+
+		log := entity.AuditLog{
+			Action:      entity.AuditActionLoginLockout,
+			IP:          req.IP,
+			Description: fmt.Sprintf("%s is locked for %s after %d failed attempts", t.kind, duration, count),
+		}
+		if t.kind == "phone_number" {
+			log.PhoneNumber = req.PhoneNumber
+		}
+
+		if _, err := s.auditRepo.CreateAuditLog(ctx, log); err != nil {
+			// TODO - log error, the lockout is applied anyway
+			fmt.Println("auditRepo.CreateAuditLog error", err)
+		}
+	}
+
+	return param.LoginFailedResponse{}, nil
+}
+
+// LoginSucceeded resets the failures of the phone number, the failures of the ip are kept,
+// otherwise an attacker could reset them by logging in to their own account.
+func (s Service) LoginSucceeded(ctx context.Context, req param.LoginSucceededRequest) (param.LoginSucceededResponse, error) {
+	const op = "loginguardservice.LoginSucceeded"
+
+	if err := s.repo.ResetFailedAttempts(ctx, s.attemptsKey("phone_number", req.PhoneNumber)); err != nil {
+		return param.LoginSucceededResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	return param.LoginSucceededResponse{}, nil
+}
+
+// lockoutDuration returns LockoutDuration * 2^extraAttempts, up to MaxLockoutDuration.
+func (s Service) lockoutDuration(extraAttempts int64) time.Duration {
+	duration := s.config.LockoutDuration
+	for i := int64(0); i < extraAttempts && duration < s.config.MaxLockoutDuration; i++ {
+		duration *= 2
+	}
+
+	return min(duration, s.config.MaxLockoutDuration)
+}
+
+func (s Service) attemptsKey(kind, value string) string {
+	return fmt.Sprintf("%s:attempts:%s:%s", s.config.Prefix, kind, value)
+}
+
+func (s Service) lockKey(kind, value string) string {
+	return fmt.Sprintf("%s:lock:%s:%s", s.config.Prefix, kind, value)
+}
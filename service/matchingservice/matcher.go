@@ -0,0 +1,71 @@
+package matchingservice
+
+import (
+	"gameAppProject/entity"
+	"sort"
+	"time"
+)
+
+type ratedMember struct {
+	entity.WaitingMember
+	rating int
+}
+
+// pairByRating pairs members with the closest ratings.
+// Members that have waited longer are paired first, and the rating gap they accept
+// grows with their waiting time up to the configured maximum.
+func (s Service) pairByRating(members []ratedMember, now int64) [][2]entity.WaitingMember {
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].Timestamp < members[j].Timestamp
+	})
+
+	paired := make([]bool, len(members))
+	pairs := make([][2]entity.WaitingMember, 0, len(members)/2)
+
+	for i := range members {
+		if paired[i] {
+			continue
+		}
+
+		allowedGap := s.allowedRatingGap(time.Duration(now-members[i].Timestamp) * time.Microsecond)
+
+		best := -1
+		bestGap := 0
+		for j := i + 1; j < len(members); j++ {
+			if paired[j] {
+				continue
+			}
+
+			gap := abs(members[i].rating - members[j].rating)
+			if gap <= allowedGap && (best == -1 || gap < bestGap) {
+				best, bestGap = j, gap
+			}
+		}
+
+		if best == -1 {
+			continue
+		}
+
+		paired[i], paired[best] = true, true
+		pairs = append(pairs, [2]entity.WaitingMember{members[i].WaitingMember, members[best].WaitingMember})
+	}
+
+	return pairs
+}
+
+func (s Service) allowedRatingGap(waited time.Duration) int {
+	gap := s.config.InitialRatingGap + int(waited.Seconds()*s.config.RatingGapGrowthPerSecond)
+	if gap > s.config.MaxRatingGap {
+		return s.config.MaxRatingGap
+	}
+
+	return gap
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -5,50 +5,171 @@ import (
 	"fmt"
 	"gameAppProject/entity"
 	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/event"
 	"gameAppProject/pkg/richerror"
 	"gameAppProject/pkg/timestamp"
-	"github.com/thoas/go-funk"
 	"sync"
 	"time"
 )
 
 type Repo interface {
-	AddToWaitingList(userID uint, category entity.Category) error
+	AddToWaitingList(ctx context.Context, userID uint, category entity.Category,
+		categories []entity.Category, staleBefore int64) (bool, error)
 	GetWaitingListByCategory(ctx context.Context, category entity.Category) ([]entity.WaitingMember, error)
+	RemoveUsersFromWaitingList(ctx context.Context, category entity.Category, userIDs []uint) error
+	RemoveMatchedUsersFromWaitingList(ctx context.Context, category entity.Category, userIDs []uint) (
+		[]entity.WaitingMember, bool, error)
+	RestoreToWaitingList(ctx context.Context, member entity.WaitingMember, categories []entity.Category) (bool, error)
+	RemoveStaleUsersFromWaitingList(ctx context.Context, category entity.Category, before int64) error
+	GetWaitingMember(ctx context.Context, userID uint, categories []entity.Category) (entity.WaitingMember, bool, error)
+	RemoveUserFromWaitingLists(ctx context.Context, userID uint, categories []entity.Category) (bool, error)
+	GetWaitingListPosition(ctx context.Context, member entity.WaitingMember, staleBefore int64) (int, int, error)
 }
 
 type PresenceClient interface {
 	GetPresence(ctx context.Context, request param.GetPresenceRequest) (param.GetPresenceResponse, error)
 }
 
+// Publisher publishes the matched users, the game service starts their game.
+type Publisher interface {
+	Publish(ctx context.Context, events ...event.Event) error
+}
+
+type RatingClient interface {
+	GetRatings(ctx context.Context, req param.GetRatingsRequest) (param.GetRatingsResponse, error)
+}
+
 type Config struct {
-	WaitingTimeout time.Duration `koanf:"waiting_timeout"`
+	WaitingTimeout  time.Duration `koanf:"waiting_timeout"`
+	OnlineThreshold time.Duration `koanf:"online_threshold"`
+	// rating gap that a new member accepts, it grows by RatingGapGrowthPerSecond while waiting
+	InitialRatingGap         int     `koanf:"initial_rating_gap"`
+	RatingGapGrowthPerSecond float64 `koanf:"rating_gap_growth_per_second"`
+	MaxRatingGap             int     `koanf:"max_rating_gap"`
 }
 
 type Service struct {
 	config         Config
 	repo           Repo
 	presenceClient PresenceClient
+	publisher      Publisher
+	ratingClient   RatingClient
 }
 
-func New(config Config, repo Repo, presenceClient PresenceClient) Service {
-	return Service{config: config, repo: repo, presenceClient: presenceClient}
+func New(config Config, repo Repo, presenceClient PresenceClient, publisher Publisher,
+	ratingClient RatingClient) Service {
+	return Service{config: config, repo: repo, presenceClient: presenceClient, publisher: publisher,
+		ratingClient: ratingClient}
 }
 
-func (s Service) AddToWaitingList(req param.AddToWaitingListRequest) (
+func (s Service) AddToWaitingList(ctx context.Context, req param.AddToWaitingListRequest) (
 	param.AddToWaitingListResponse, error) {
 	const op = richerror.Op("matchingservice.AddToWaitingList")
 
-	// add user to the waiting list for the given category if not exist
-	err := s.repo.AddToWaitingList(req.UserID, req.Category)
+	// add user to the waiting list for the given category if not exist,
+	// a user waits for one category at a time so it isn't matched in two games
+	added, err := s.repo.AddToWaitingList(ctx, req.UserID, req.Category, entity.CategoryList(),
+		timestamp.Add(-s.config.WaitingTimeout))
 	if err != nil {
 		return param.AddToWaitingListResponse{},
 			richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
 	}
 
+	if !added {
+		return param.AddToWaitingListResponse{}, richerror.New(op).
+			WithMessage(errmsg.ErrorMsgUserIsWaitingElsewhere).WithKind(richerror.KindInvalid)
+	}
+
 	return param.AddToWaitingListResponse{Timeout: s.config.WaitingTimeout}, nil
 }
 
+func (s Service) LeaveWaitingList(ctx context.Context, req param.LeaveWaitingListRequest) (
+	param.LeaveWaitingListResponse, error) {
+	const op = richerror.Op("matchingservice.LeaveWaitingList")
+
+	removed, err := s.repo.RemoveUserFromWaitingLists(ctx, req.UserID, entity.CategoryList())
+	if err != nil {
+		return param.LeaveWaitingListResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if !removed {
+		return param.LeaveWaitingListResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgUserIsNotWaiting).
+			WithKind(richerror.KindNotFound)
+	}
+
+	return param.LeaveWaitingListResponse{}, nil
+}
+
+func (s Service) GetWaitingStatus(ctx context.Context, req param.GetWaitingStatusRequest) (
+	param.GetWaitingStatusResponse, error) {
+	const op = richerror.Op("matchingservice.GetWaitingStatus")
+
+	member, found, err := s.repo.GetWaitingMember(ctx, req.UserID, entity.CategoryList())
+	if err != nil {
+		return param.GetWaitingStatusResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	// the stale members are removed in the next matching round, they aren't waiting anymore
+	waitingTime := time.Duration(timestamp.Now()-member.Timestamp) * time.Microsecond
+	if !found || waitingTime >= s.config.WaitingTimeout {
+		return param.GetWaitingStatusResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgUserIsNotWaiting).
+			WithKind(richerror.KindNotFound)
+	}
+
+	position, queueSize, err := s.repo.GetWaitingListPosition(ctx, member, timestamp.Add(-s.config.WaitingTimeout))
+	if err != nil {
+		return param.GetWaitingStatusResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	return param.GetWaitingStatusResponse{
+		Category:         member.Category,
+		WaitingTime:      waitingTime,
+		RemainingTimeout: s.config.WaitingTimeout - waitingTime,
+		Position:         position,
+		QueueSize:        queueSize,
+	}, nil
+}
+
+// ClaimMatchedUsers removes the matched users from the waiting list before their game is created,
+// it returns false if any of them isn't waiting anymore, e.g. another round has already claimed them.
+func (s Service) ClaimMatchedUsers(ctx context.Context, req param.ClaimMatchedUsersRequest) (
+	param.ClaimMatchedUsersResponse, error) {
+	const op = richerror.Op("matchingservice.ClaimMatchedUsers")
+
+	users, claimed, err := s.repo.RemoveMatchedUsersFromWaitingList(ctx, req.Category, req.UserIDs)
+	if err != nil {
+		return param.ClaimMatchedUsersResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	return param.ClaimMatchedUsersResponse{Claimed: claimed, Users: users}, nil
+}
+
+// RestoreMatchedUsers puts the claimed users back to the waiting list when their game can't be created,
+// so they can be matched in the next round, they keep the time they have joined, so they don't lose their turn.
+// the users that have joined another category meanwhile are left there.
+func (s Service) RestoreMatchedUsers(ctx context.Context, req param.RestoreMatchedUsersRequest) (
+	param.RestoreMatchedUsersResponse, error) {
+	const op = richerror.Op("matchingservice.RestoreMatchedUsers")
+
+	var lastErr error
+	for _, user := range req.Users {
+		user.Category = req.Category
+		if _, err := s.repo.RestoreToWaitingList(ctx, user, entity.CategoryList()); err != nil {
+			// TODO - log error
+			fmt.Println("repo.RestoreToWaitingList error", err)
+
+			lastErr = err
+		}
+	}
+
+	if lastErr != nil {
+		return param.RestoreMatchedUsersResponse{}, richerror.New(op).WithErr(lastErr)
+	}
+
+	return param.RestoreMatchedUsersResponse{}, nil
+}
+
 func (s Service) MatchWaitedUsers(ctx context.Context, _ param.MatchWaitedUsersRequest) (param.MatchWaitedUsersResponse, error) {
 	const op = richerror.Op("matchingservice.MatchWaitedUsers")
 
@@ -68,6 +189,14 @@ func (s Service) match(ctx context.Context, category entity.Category, wg *sync.W
 
 	defer wg.Done()
 
+	// users that have waited longer than the waiting timeout leave the waiting list
+	if err := s.repo.RemoveStaleUsersFromWaitingList(ctx, category,
+		timestamp.Add(-s.config.WaitingTimeout)); err != nil {
+		// TODO - log error
+		// TODO - update metrics
+		fmt.Println("repo.RemoveStaleUsersFromWaitingList error", err)
+	}
+
 	list, err := s.repo.GetWaitingListByCategory(ctx, category)
 	if err != nil {
 		// TODO - log error
@@ -75,7 +204,7 @@ func (s Service) match(ctx context.Context, category entity.Category, wg *sync.W
 		return
 	}
 
-	userIDs := make([]uint, len(list))
+	userIDs := make([]uint, 0, len(list))
 	for _, l := range list {
 		userIDs = append(userIDs, l.UserID)
 	}
@@ -87,35 +216,83 @@ func (s Service) match(ctx context.Context, category entity.Category, wg *sync.W
 		return
 	}
 
-	presenceUserIDs := make([]uint, len(list))
+	presenceTimestamps := make(map[uint]int64, len(presenceList.Items))
 	for _, l := range presenceList.Items {
-		presenceUserIDs = append(presenceUserIDs, l.UserID)
+		presenceTimestamps[l.UserID] = l.Timestamp
 	}
 
-	// TODO - merge presenceList with list based on userID
-	// also consider the presence timestamp of each user
-	// and remove users from waiting list if the user's timestamp is older than time.Now(-20 seconds)
-	//if t < timestamp.Add(-20*time.Second) {
-	//	// remove from list
-	//}
-
 	var finalList = make([]entity.WaitingMember, 0)
+	var offlineUserIDs = make([]uint, 0)
 	for _, l := range list {
-		if funk.ContainsUInt(presenceUserIDs, l.UserID) && l.Timestamp < timestamp.Add(-20*time.Second) {
+		t, ok := presenceTimestamps[l.UserID]
+		if ok && t > timestamp.Add(-s.config.OnlineThreshold) {
 			finalList = append(finalList, l)
 		} else {
-			// remove from waiting list
+			offlineUserIDs = append(offlineUserIDs, l.UserID)
 		}
 	}
 
-	for i := 0; i < len(finalList)-1; i = i + 2 {
+	if len(offlineUserIDs) > 0 {
+		if err := s.repo.RemoveUsersFromWaitingList(ctx, category, offlineUserIDs); err != nil {
+			// TODO - log error
+			// TODO - update metrics
+			fmt.Println("repo.RemoveUsersFromWaitingList error", err)
+		}
+	}
+
+	if len(finalList) < 2 {
+		return
+	}
+
+	finalUserIDs := make([]uint, 0, len(finalList))
+	for _, l := range finalList {
+		finalUserIDs = append(finalUserIDs, l.UserID)
+	}
+
+	ratings, err := s.ratingClient.GetRatings(ctx, param.GetRatingsRequest{Category: category, UserIDs: finalUserIDs})
+	if err != nil {
+		// TODO - log error
+		// TODO - update metrics
+		fmt.Println("ratingClient.GetRatings error", err)
+
+		return
+	}
+
+	userRatings := make(map[uint]int, len(ratings.Items))
+	for _, r := range ratings.Items {
+		userRatings[r.UserID] = r.Rating
+	}
+
+	members := make([]ratedMember, 0, len(finalList))
+	for _, l := range finalList {
+		members = append(members, ratedMember{WaitingMember: l, rating: userRatings[l.UserID]})
+	}
 
-		mu := entity.MatchedUsers{
+	// the matched users stay in the waiting list until their game is started,
+	// so they're matched again in the next round if the event is lost
+	events := make([]event.Event, 0)
+	for _, pair := range s.pairByRating(members, timestamp.Now()) {
+		e, err := event.New(entity.UsersMatchedEvent, 1, string(category), entity.UsersMatched{
 			Category: category,
-			UserID:   []uint{finalList[i].UserID, finalList[i+1].UserID},
+			UserIDs:  []uint{pair[0].UserID, pair[1].UserID},
+		})
+		if err != nil {
+			// TODO - log error
+			fmt.Println("event.New error", err)
+
+			continue
 		}
-		fmt.Println("mu", mu)
-		// publish a new event for mu
-		// remove mu users from waiting list
+
+		events = append(events, e)
+	}
+
+	if len(events) == 0 {
+		return
+	}
+
+	if err := s.publisher.Publish(ctx, events...); err != nil {
+		// TODO - log error
+		// TODO - update metrics
+		fmt.Println("publisher.Publish error", err)
 	}
 }
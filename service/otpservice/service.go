@@ -0,0 +1,109 @@
+package otpservice
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"math/big"
+	"time"
+)
+
+type Config struct {
+	CodeLength     int           `koanf:"code_length"`
+	ExpirationTime time.Duration `koanf:"expiration_time"`
+	// MaxAttempts is the number of the attempts of a phone number in AttemptsWindow,
+	// requesting a new code doesn't reset them
+	MaxAttempts    int           `koanf:"max_attempts"`
+	AttemptsWindow time.Duration `koanf:"attempts_window"`
+	// HashKey is the hmac key of the stored codes, so the codes can't be read from redis
+	HashKey string `koanf:"hash_key"`
+	Prefix  string `koanf:"prefix"`
+}
+
+type Repository interface {
+	StoreCode(ctx context.Context, key, hashedCode string, expTime time.Duration) error
+	VerifyCode(ctx context.Context, key, hashedCode string, maxAttempts int, attemptsWindow time.Duration) (bool, error)
+}
+
+type SMSSender interface {
+	Send(ctx context.Context, phoneNumber, message string) error
+}
+
+type Service struct {
+	config    Config
+	repo      Repository
+	smsSender SMSSender
+}
+
+func New(config Config, repo Repository, smsSender SMSSender) Service {
+	return Service{config: config, repo: repo, smsSender: smsSender}
+}
+
+// SendCode sends a new code, the previous code of the phone number for the purpose is not valid anymore.
+func (s Service) SendCode(ctx context.Context, req param.SendOTPRequest) (param.SendOTPResponse, error) {
+	const op = "otpservice.SendCode"
+
+	code, err := s.generateCode()
+	if err != nil {
+		return param.SendOTPResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := s.repo.StoreCode(ctx, s.key(req.Purpose, req.PhoneNumber),
+		s.hashCode(req.Purpose, req.PhoneNumber, code), s.config.ExpirationTime); err != nil {
+		return param.SendOTPResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if err := s.smsSender.Send(ctx, req.PhoneNumber, fmt.Sprintf("your gameapp code is %s", code)); err != nil {
+		return param.SendOTPResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected).
+			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+	}
+
+	return param.SendOTPResponse{}, nil
+}
+
+// VerifyCode consumes the code, a code can be verified once.
+func (s Service) VerifyCode(ctx context.Context, req param.VerifyOTPRequest) (param.VerifyOTPResponse, error) {
+	const op = "otpservice.VerifyCode"
+
+	ok, err := s.repo.VerifyCode(ctx, s.key(req.Purpose, req.PhoneNumber),
+		s.hashCode(req.Purpose, req.PhoneNumber, req.Code), s.config.MaxAttempts, s.config.AttemptsWindow)
+	if err != nil {
+		return param.VerifyOTPResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if !ok {
+		return param.VerifyOTPResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgOTPIsInvalid).
+			WithKind(richerror.KindInvalid).WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+	}
+
+	return param.VerifyOTPResponse{}, nil
+}
+
+func (s Service) generateCode() (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(s.config.CodeLength)), nil)
+
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", s.config.CodeLength, n), nil
+}
+
+func (s Service) hashCode(purpose entity.OTPPurpose, phoneNumber, code string) string {
+	mac := hmac.New(sha256.New, []byte(s.config.HashKey))
+	mac.Write([]byte(fmt.Sprintf("%s:%s:%s", purpose, phoneNumber, code)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s Service) key(purpose entity.OTPPurpose, phoneNumber string) string {
+	return fmt.Sprintf("%s:%s:%s", s.config.Prefix, purpose, phoneNumber)
+}
@@ -0,0 +1,105 @@
+package outboxservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/event"
+	"gameAppProject/pkg/richerror"
+	"strings"
+	"time"
+)
+
+// Relay publishes the saved events in order, only one relay should run at a time.
+// An event may be published more than once, e.g. if it can't be marked as published.
+func (s Service) Relay(ctx context.Context, _ param.RelayOutboxRequest) (param.RelayOutboxResponse, error) {
+	const op = richerror.Op("outboxservice.Relay")
+
+	now := time.Now()
+
+	outboxEvents, err := s.repo.GetUnpublishedOutboxEvents(ctx, now, s.config.BatchSize)
+	if err != nil {
+		return param.RelayOutboxResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	resp := param.RelayOutboxResponse{}
+	publishedIDs := make([]uint, 0, len(outboxEvents))
+
+	// the later events of an aggregate wait until its failed event is published or dead, so the order is kept
+	blocked := make(map[string]bool)
+
+	for _, oe := range outboxEvents {
+		key := aggregateKey(oe)
+		if blocked[key] {
+			continue
+		}
+
+		if err := s.publish(ctx, oe); err != nil {
+			if oe.Attempts+1 >= s.config.MaxAttempts {
+				resp.Dead++
+
+				if mErr := s.repo.MarkOutboxEventDead(ctx, oe.ID, err.Error()); mErr != nil {
+					// TODO - log error
+					fmt.Println("repo.MarkOutboxEventDead error", mErr)
+				}
+
+				// TODO - log error, the event is not published anymore
+				fmt.Println("outbox event is dead", oe.EventID, err)
+
+				continue
+			}
+
+			blocked[key] = true
+			resp.Failed++
+
+			if mErr := s.repo.MarkOutboxEventFailed(ctx, oe.ID, err.Error(), now.Add(s.retryDelay(oe.Attempts))); mErr != nil {
+				// TODO - log error
+				fmt.Println("repo.MarkOutboxEventFailed error", mErr)
+			}
+
+			continue
+		}
+
+		publishedIDs = append(publishedIDs, oe.ID)
+	}
+
+	if err := s.repo.MarkOutboxEventsPublished(ctx, publishedIDs); err != nil {
+		return param.RelayOutboxResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	resp.Published = len(publishedIDs)
+
+	if err := s.repo.DeletePublishedOutboxEvents(ctx, now.Add(-s.config.Retention)); err != nil {
+		// TODO - log error
+		fmt.Println("repo.DeletePublishedOutboxEvents error", err)
+	}
+
+	return resp, nil
+}
+
+func (s Service) publish(ctx context.Context, oe entity.OutboxEvent) error {
+	var e event.Event
+	if err := json.Unmarshal(oe.Payload, &e); err != nil {
+		return err
+	}
+
+	return s.publisher.Publish(ctx, e)
+}
+
+func (s Service) retryDelay(attempts int) time.Duration {
+	delay := s.config.RetryDelay
+	for i := 0; i < attempts && delay < s.config.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, s.config.MaxRetryDelay)
+}
+
+// aggregateKey is the aggregate type and id, e.g. game:12, the ids of different types may be the same.
+func aggregateKey(oe entity.OutboxEvent) string {
+	aggregateType, _, _ := strings.Cut(string(oe.EventType), ".")
+
+	return aggregateType + ":" + oe.AggregateID
+}
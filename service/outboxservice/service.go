@@ -0,0 +1,41 @@
+package outboxservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/event"
+	"time"
+)
+
+type Config struct {
+	BatchSize int `koanf:"batch_size"`
+	// a failed event is retried after RetryDelay, the delay doubles on every attempt up to MaxRetryDelay
+	RetryDelay    time.Duration `koanf:"retry_delay"`
+	MaxRetryDelay time.Duration `koanf:"max_retry_delay"`
+	// an event is marked as dead after MaxAttempts failed attempts, the later events of its aggregate go on
+	MaxAttempts int `koanf:"max_attempts"`
+	// the published events are deleted after Retention
+	Retention time.Duration `koanf:"retention"`
+}
+
+type Repository interface {
+	GetUnpublishedOutboxEvents(ctx context.Context, now time.Time, limit int) ([]entity.OutboxEvent, error)
+	MarkOutboxEventsPublished(ctx context.Context, ids []uint) error
+	MarkOutboxEventFailed(ctx context.Context, id uint, lastError string, nextAttemptAt time.Time) error
+	MarkOutboxEventDead(ctx context.Context, id uint, lastError string) error
+	DeletePublishedOutboxEvents(ctx context.Context, before time.Time) error
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, events ...event.Event) error
+}
+
+type Service struct {
+	config    Config
+	repo      Repository
+	publisher Publisher
+}
+
+func New(config Config, repo Repository, publisher Publisher) Service {
+	return Service{config: config, repo: repo, publisher: publisher}
+}
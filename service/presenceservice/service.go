@@ -15,6 +15,7 @@ type Config struct {
 
 type Repo interface {
 	Upsert(ctx context.Context, key string, timestamp int64, expTime time.Duration) error
+	GetPresence(ctx context.Context, keys []string) (map[string]int64, error)
 }
 
 type Service struct {
@@ -29,9 +30,7 @@ func New(config Config, repo Repo) Service {
 func (s Service) Upsert(ctx context.Context, req param.UpsertPresenceRequest) (param.UpsertPresenceResponse, error) {
 	const op = richerror.Op("presenceservice.Upsert")
 
-	err := s.repo.Upsert(ctx,
-		fmt.Sprintf("%s:%d", s.config.Prefix, req.UserID),
-		req.Timestamp, s.config.ExpirationTime)
+	err := s.repo.Upsert(ctx, s.key(req.UserID), req.Timestamp, s.config.ExpirationTime)
 	if err != nil {
 		fmt.Println("UpsertPresence2 err", err.Error())
 		return param.UpsertPresenceResponse{}, richerror.New(op).WithErr(err)
@@ -41,5 +40,32 @@ func (s Service) Upsert(ctx context.Context, req param.UpsertPresenceRequest) (p
 }
 
 func (s Service) GetPresence(ctx context.Context, request param.GetPresenceRequest) (param.GetPresenceResponse, error) {
-	return param.GetPresenceResponse{}, nil
+	const op = richerror.Op("presenceservice.GetPresence")
+
+	keys := make([]string, 0, len(request.UserIDs))
+	for _, userID := range request.UserIDs {
+		keys = append(keys, s.key(userID))
+	}
+
+	timestamps, err := s.repo.GetPresence(ctx, keys)
+	if err != nil {
+		return param.GetPresenceResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	items := make([]param.GetPresenceItem, 0, len(timestamps))
+	for _, userID := range request.UserIDs {
+		// users whose key has expired are offline
+		t, ok := timestamps[s.key(userID)]
+		if !ok {
+			continue
+		}
+
+		items = append(items, param.GetPresenceItem{UserID: userID, Timestamp: t})
+	}
+
+	return param.GetPresenceResponse{Items: items}, nil
+}
+
+func (s Service) key(userID uint) string {
+	return fmt.Sprintf("%s:%d", s.config.Prefix, userID)
 }
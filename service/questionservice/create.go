@@ -0,0 +1,25 @@
+package questionservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+func (s Service) Create(ctx context.Context, req param.QuestionCreateRequest) (param.QuestionCreateResponse, error) {
+	const op = "questionservice.Create"
+
+	q, err := s.repo.CreateQuestion(ctx, entity.Question{
+		Text:            req.Text,
+		PossibleAnswers: toPossibleAnswerEntities(req.PossibleAnswers),
+		CorrectAnswer:   req.CorrectAnswer,
+		Difficulty:      req.Difficulty,
+		Category:        req.Category,
+	})
+	if err != nil {
+		return param.QuestionCreateResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	return param.QuestionCreateResponse{Question: toQuestionInfo(q)}, nil
+}
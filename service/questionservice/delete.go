@@ -0,0 +1,18 @@
+package questionservice
+
+import (
+	"context"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+func (s Service) Delete(ctx context.Context, req param.QuestionDeleteRequest) (param.QuestionDeleteResponse, error) {
+	const op = "questionservice.Delete"
+
+	if err := s.repo.DeleteQuestion(ctx, req.ID); err != nil {
+		return param.QuestionDeleteResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"question_id": req.ID})
+	}
+
+	return param.QuestionDeleteResponse{}, nil
+}
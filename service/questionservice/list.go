@@ -0,0 +1,33 @@
+package questionservice
+
+import (
+	"context"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+func (s Service) List(ctx context.Context, req param.QuestionListRequest) (param.QuestionListResponse, error) {
+	const op = "questionservice.List"
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	page := req.Page
+	if page == 0 {
+		page = 1
+	}
+
+	questions, total, err := s.repo.ListQuestions(ctx, req.Category, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return param.QuestionListResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	infos := make([]param.QuestionInfo, 0, len(questions))
+	for _, q := range questions {
+		infos = append(infos, toQuestionInfo(q))
+	}
+
+	return param.QuestionListResponse{Questions: infos, Total: total}, nil
+}
@@ -0,0 +1,49 @@
+package questionservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+)
+
+const defaultPageSize = 10
+
+type Repository interface {
+	CreateQuestion(ctx context.Context, q entity.Question) (entity.Question, error)
+	UpdateQuestion(ctx context.Context, q entity.Question) (entity.Question, error)
+	DeleteQuestion(ctx context.Context, questionID uint) error
+	ListQuestions(ctx context.Context, category entity.Category, offset, limit uint) ([]entity.Question, uint, error)
+}
+
+type Service struct {
+	repo Repository
+}
+
+func New(repo Repository) Service {
+	return Service{repo: repo}
+}
+
+func toQuestionInfo(q entity.Question) param.QuestionInfo {
+	answers := make([]param.PossibleAnswerInfo, 0, len(q.PossibleAnswers))
+	for _, a := range q.PossibleAnswers {
+		answers = append(answers, param.PossibleAnswerInfo{Text: a.Text, Choice: a.Choice})
+	}
+
+	return param.QuestionInfo{
+		ID:              q.ID,
+		Text:            q.Text,
+		PossibleAnswers: answers,
+		CorrectAnswer:   q.CorrectAnswer,
+		Difficulty:      q.Difficulty,
+		Category:        q.Category,
+	}
+}
+
+func toPossibleAnswerEntities(answers []param.PossibleAnswerInfo) []entity.PossibleAnswer {
+	result := make([]entity.PossibleAnswer, 0, len(answers))
+	for _, a := range answers {
+		result = append(result, entity.PossibleAnswer{Text: a.Text, Choice: a.Choice})
+	}
+
+	return result
+}
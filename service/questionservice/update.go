@@ -0,0 +1,27 @@
+package questionservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+func (s Service) Update(ctx context.Context, req param.QuestionUpdateRequest) (param.QuestionUpdateResponse, error) {
+	const op = "questionservice.Update"
+
+	q, err := s.repo.UpdateQuestion(ctx, entity.Question{
+		ID:              req.ID,
+		Text:            req.Text,
+		PossibleAnswers: toPossibleAnswerEntities(req.PossibleAnswers),
+		CorrectAnswer:   req.CorrectAnswer,
+		Difficulty:      req.Difficulty,
+		Category:        req.Category,
+	})
+	if err != nil {
+		return param.QuestionUpdateResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"question_id": req.ID})
+	}
+
+	return param.QuestionUpdateResponse{Question: toQuestionInfo(q)}, nil
+}
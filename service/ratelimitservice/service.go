@@ -0,0 +1,43 @@
+package ratelimitservice
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+	"time"
+)
+
+type Config struct {
+	Prefix string `koanf:"prefix"`
+}
+
+type Repository interface {
+	Increment(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+}
+
+type Service struct {
+	config Config
+	repo   Repository
+}
+
+func New(config Config, repo Repository) Service {
+	return Service{config: config, repo: repo}
+}
+
+// Allow counts a hit for the key, it's not allowed when the key has more than Limit hits in the window.
+func (s Service) Allow(ctx context.Context, req param.RateLimitRequest) (param.RateLimitResponse, error) {
+	const op = "ratelimitservice.Allow"
+
+	count, ttl, err := s.repo.Increment(ctx, fmt.Sprintf("%s:%s", s.config.Prefix, req.Key), req.Window)
+	if err != nil {
+		return param.RateLimitResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"key": req.Key})
+	}
+
+	if count > req.Limit {
+		return param.RateLimitResponse{Allowed: false, RetryAfter: ttl}, nil
+	}
+
+	return param.RateLimitResponse{Allowed: true}, nil
+}
@@ -0,0 +1,23 @@
+package ratingservice
+
+import (
+	"context"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+func (s Service) GetRatings(ctx context.Context, req param.GetRatingsRequest) (param.GetRatingsResponse, error) {
+	const op = richerror.Op("ratingservice.GetRatings")
+
+	ratings, err := s.getRatings(ctx, req.Category, req.UserIDs)
+	if err != nil {
+		return param.GetRatingsResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	items := make([]param.GetRatingItem, 0, len(req.UserIDs))
+	for _, userID := range req.UserIDs {
+		items = append(items, param.GetRatingItem{UserID: userID, Rating: ratings[userID].Rating})
+	}
+
+	return param.GetRatingsResponse{Items: items}, nil
+}
@@ -0,0 +1,44 @@
+package ratingservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+)
+
+type Repository interface {
+	GetRatings(ctx context.Context, category entity.Category, userIDs []uint) ([]entity.Rating, error)
+	ApplyRatingChanges(ctx context.Context, gameID uint, initialRating int, changes []entity.RatingChange) (bool, error)
+}
+
+type Config struct {
+	InitialRating int     `koanf:"initial_rating"`
+	KFactor       float64 `koanf:"k_factor"`
+}
+
+type Service struct {
+	config Config
+	repo   Repository
+}
+
+func New(config Config, repo Repository) Service {
+	return Service{config: config, repo: repo}
+}
+
+// getRatings returns the rating of every given user, users without a stored rating get the initial rating.
+func (s Service) getRatings(ctx context.Context, category entity.Category, userIDs []uint) (map[uint]entity.Rating, error) {
+	stored, err := s.repo.GetRatings(ctx, category, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make(map[uint]entity.Rating, len(userIDs))
+	for _, userID := range userIDs {
+		ratings[userID] = entity.Rating{UserID: userID, Category: category, Rating: s.config.InitialRating}
+	}
+
+	for _, r := range stored {
+		ratings[r.UserID] = r
+	}
+
+	return ratings, nil
+}
@@ -0,0 +1,73 @@
+package ratingservice
+
+import (
+	"context"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+	"math"
+)
+
+// UpdateRatings applies the elo formula to every pair of players of a finished game,
+// the player with the higher score wins the pair and equal scores are a draw.
+// the ratings of a game are applied once, so the request can be retried.
+// the changes are added to the stored ratings, so the games of a player that finish together all count.
+func (s Service) UpdateRatings(ctx context.Context, req param.UpdateRatingsRequest) (param.UpdateRatingsResponse, error) {
+	const op = richerror.Op("ratingservice.UpdateRatings")
+
+	if len(req.Scores) < 2 {
+		return param.UpdateRatingsResponse{}, nil
+	}
+
+	userIDs := make([]uint, 0, len(req.Scores))
+	for _, ps := range req.Scores {
+		userIDs = append(userIDs, ps.UserID)
+	}
+
+	ratings, err := s.getRatings(ctx, req.Category, userIDs)
+	if err != nil {
+		return param.UpdateRatingsResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	opponents := float64(len(req.Scores) - 1)
+	changes := make([]entity.RatingChange, 0, len(req.Scores))
+
+	for _, p := range req.Scores {
+		delta := 0.0
+		for _, o := range req.Scores {
+			if o.UserID == p.UserID {
+				continue
+			}
+
+			delta += s.config.KFactor * (actualScore(p.Score, o.Score) -
+				expectedScore(ratings[p.UserID].Rating, ratings[o.UserID].Rating)) / opponents
+		}
+
+		changes = append(changes, entity.RatingChange{
+			UserID:   p.UserID,
+			Category: req.Category,
+			Delta:    int(math.Round(delta)),
+		})
+	}
+
+	if _, err := s.repo.ApplyRatingChanges(ctx, req.GameID, s.config.InitialRating, changes); err != nil {
+		return param.UpdateRatingsResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	return param.UpdateRatingsResponse{}, nil
+}
+
+func expectedScore(rating, opponentRating int) float64 {
+	return 1 / (1 + math.Pow(10, float64(opponentRating-rating)/400))
+}
+
+func actualScore(score, opponentScore uint) float64 {
+	switch {
+	case score > opponentScore:
+		return 1
+	case score < opponentScore:
+		return 0
+	default:
+		return 0.5
+	}
+}
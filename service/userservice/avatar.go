@@ -0,0 +1,140 @@
+package userservice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/imageutil"
+	"gameAppProject/pkg/richerror"
+	"github.com/google/uuid"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// avatarFormats maps the allowed content types to the file extensions.
+var avatarFormats = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+}
+
+// UploadAvatar stores the avatar and its thumbnail, the previous avatar is deleted.
+// The image is encoded again, so the metadata of the uploaded file, e.g. the location, is not kept.
+func (s Service) UploadAvatar(ctx context.Context, req param.UploadAvatarRequest) (param.UploadAvatarResponse, error) {
+	const op = "userservice.UploadAvatar"
+
+	data, err := io.ReadAll(io.LimitReader(req.File, s.config.AvatarMaxSize+1))
+	if err != nil {
+		return param.UploadAvatarResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	if int64(len(data)) > s.config.AvatarMaxSize {
+		return param.UploadAvatarResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgAvatarIsTooLarge).
+			WithKind(richerror.KindInvalid).WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	ext, ok := avatarFormats[http.DetectContentType(data)]
+	if !ok {
+		return param.UploadAvatarResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgAvatarTypeIsNotValid).
+			WithKind(richerror.KindInvalid).WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	// check the dimensions before decoding, a small file can be decoded to a huge image
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width > s.config.AvatarMaxDimension || cfg.Height > s.config.AvatarMaxDimension {
+		return param.UploadAvatarResponse{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgAvatarTypeIsNotValid).WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return param.UploadAvatarResponse{}, richerror.New(op).WithErr(err).
+			WithMessage(errmsg.ErrorMsgAvatarTypeIsNotValid).WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	avatar, err := encodeImage(img, ext)
+	if err != nil {
+		return param.UploadAvatarResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	thumbnail, err := encodeImage(imageutil.Thumbnail(img, s.config.AvatarThumbnailSize), ext)
+	if err != nil {
+		return param.UploadAvatarResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	user, err := s.repo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return param.UploadAvatarResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	key := fmt.Sprintf("avatars/%d/%s.%s", req.UserID, uuid.NewString(), ext)
+
+	if err := s.avatarStorage.Save(ctx, key, avatar); err != nil {
+		return param.UploadAvatarResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := s.avatarStorage.Save(ctx, avatarThumbnailKey(key), thumbnail); err != nil {
+		s.deleteAvatar(ctx, key)
+
+		return param.UploadAvatarResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := s.repo.UpdateAvatar(ctx, req.UserID, key); err != nil {
+		// the new files are not referenced by the user
+		s.deleteAvatar(ctx, key)
+
+		return param.UploadAvatarResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	// the old files are deleted only after the user references the new ones
+	if user.Avatar != "" {
+		s.deleteAvatar(ctx, user.Avatar)
+	}
+
+	return param.UploadAvatarResponse{
+		Avatar:          s.avatarStorage.URL(key),
+		AvatarThumbnail: s.avatarStorage.URL(avatarThumbnailKey(key)),
+	}, nil
+}
+
+func (s Service) deleteAvatar(ctx context.Context, key string) {
+	for _, k := range []string{key, avatarThumbnailKey(key)} {
+		if err := s.avatarStorage.Delete(ctx, k); err != nil {
+			// TODO - log error, the file is not used anymore
+			fmt.Println("avatarStorage.Delete error", err)
+		}
+	}
+}
+
+func avatarThumbnailKey(key string) string {
+	ext := path.Ext(key)
+
+	return strings.TrimSuffix(key, ext) + "_thumb" + ext
+}
+
+func encodeImage(img image.Image, ext string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var err error
+	if ext == "png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -1,33 +1,72 @@
 package userservice
 
 import (
+	"context"
 	"fmt"
 	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
 	"gameAppProject/pkg/richerror"
 )
 
-func (s Service) Login(req param.LoginRequest) (param.LoginResponse, error) {
+func (s Service) Login(ctx context.Context, req param.LoginRequest) (param.LoginResponse, error) {
 	const op = "userservice.Login"
 
-	// TODO - it would be better to user two separate method for existence check and getUserByPhoneNumber
-	user, err := s.repo.GetUserByPhoneNumber(req.PhoneNumber)
+	check, err := s.loginGuard.CheckLogin(ctx, param.CheckLoginRequest{PhoneNumber: req.PhoneNumber, IP: req.IP})
 	if err != nil {
-		return param.LoginResponse{}, richerror.New(op).WithErr(err).
-			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+		return param.LoginResponse{}, richerror.New(op).WithErr(err)
 	}
 
-	if user.Password != getMD5Hash(req.Password) {
-		return param.LoginResponse{}, fmt.Errorf("username or password isn't correct")
+	if check.RetryAfter > 0 {
+		return param.LoginResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgTooManyRequests).
+			WithKind(richerror.KindTooManyRequests).
+			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber, "retry_after": check.RetryAfter})
 	}
 
-	accessToken, err := s.auth.CreateAccessToken(user)
+	// a missing user and a wrong password have the same response, so it doesn't tell whether a user exists
+	user, err := s.repo.GetUserByPhoneNumber(req.PhoneNumber)
 	if err != nil {
-		return param.LoginResponse{}, fmt.Errorf("unexpected error: %w", err)
+		re, ok := err.(richerror.RichError)
+		if !ok || re.Kind() != richerror.KindNotFound {
+			return param.LoginResponse{}, richerror.New(op).WithErr(err).
+				WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+		}
+
+		s.hashers.current.Compare(s.hashers.dummyHash, req.Password)
+
+		return param.LoginResponse{}, s.loginFailed(ctx, req)
+	}
+
+	ok, needsRehash := s.verifyPassword(user.Password, req.Password)
+	if !ok {
+		return param.LoginResponse{}, s.loginFailed(ctx, req)
+	}
+
+	if _, err := s.loginGuard.LoginSucceeded(ctx, param.LoginSucceededRequest{PhoneNumber: req.PhoneNumber}); err != nil {
+		// TODO - log error, the user can log in anyway
+		fmt.Println("loginGuard.LoginSucceeded error", err)
+	}
+
+	if !user.IsVerified() {
+		return param.LoginResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgPhoneNumberIsNotVerified).
+			WithKind(richerror.KindForbidden).WithMeta(map[string]interface{}{"user_id": user.ID})
+	}
+
+	if user.IsBanned() {
+		return param.LoginResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgUserIsBanned).
+			WithKind(richerror.KindForbidden).WithMeta(map[string]interface{}{"user_id": user.ID})
 	}
 
-	refreshToken, err := s.auth.CreateRefreshToken(user)
+	// upgrade legacy hashes while we have the plain password
+	if needsRehash {
+		if err := s.rehashPassword(user.ID, req.Password); err != nil {
+			// TODO - log error, the user can still log in with the legacy hash
+			fmt.Println("userservice.rehashPassword error", err)
+		}
+	}
+
+	tokens, err := s.createTokens(user)
 	if err != nil {
-		return param.LoginResponse{}, fmt.Errorf("unexpected error: %w", err)
+		return param.LoginResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
 	}
 
 	return param.LoginResponse{
@@ -36,8 +75,31 @@ func (s Service) Login(req param.LoginRequest) (param.LoginResponse, error) {
 			PhoneNumber: user.PhoneNumber,
 			Name:        user.Name,
 		},
-		Tokens: param.Tokens{
-			AccessToken:  accessToken,
-			RefreshToken: refreshToken},
+		Tokens: tokens,
 	}, nil
 }
+
+// loginFailed counts the failure and returns the uniform invalid credentials error.
+func (s Service) loginFailed(ctx context.Context, req param.LoginRequest) error {
+	const op = "userservice.loginFailed"
+
+	if _, err := s.loginGuard.LoginFailed(ctx, param.LoginFailedRequest{
+		PhoneNumber: req.PhoneNumber,
+		IP:          req.IP,
+	}); err != nil {
+		// TODO - log error
+		fmt.Println("loginGuard.LoginFailed error", err)
+	}
+
+	return richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidCredentials).WithKind(richerror.KindUnauthorized).
+		WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+}
+
+func (s Service) rehashPassword(userID uint, password string) error {
+	hashedPassword, err := s.hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdatePassword(context.Background(), userID, hashedPassword)
+}
@@ -0,0 +1,171 @@
+package userservice
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"time"
+)
+
+// SendLoginCode sends a login code to the registered phone numbers,
+// the response is the same in all cases, so it doesn't tell whether a phone number is registered.
+func (s Service) SendLoginCode(ctx context.Context, req param.SendLoginCodeRequest) (param.SendLoginCodeResponse, error) {
+	const op = "userservice.SendLoginCode"
+
+	if err := s.checkOTPRateLimit(ctx, "send_login_code", req.PhoneNumber, req.IP, s.otpLoginRateLimit()); err != nil {
+		return param.SendLoginCodeResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	_, err := s.repo.GetUserByPhoneNumber(req.PhoneNumber)
+	if err != nil {
+		if re, ok := err.(richerror.RichError); ok && re.Kind() == richerror.KindNotFound {
+			return param.SendLoginCodeResponse{}, nil
+		}
+
+		return param.SendLoginCodeResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+	}
+
+	if _, err := s.otpClient.SendCode(ctx, param.SendOTPRequest{
+		PhoneNumber: req.PhoneNumber,
+		Purpose:     entity.OTPPurposeLogin,
+	}); err != nil {
+		return param.SendLoginCodeResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	return param.SendLoginCodeResponse{}, nil
+}
+
+// LoginWithOTP logs in with the code instead of the password,
+// the code proves the phone number too, so an unverified user is verified by it.
+func (s Service) LoginWithOTP(ctx context.Context, req param.LoginWithOTPRequest) (param.LoginWithOTPResponse, error) {
+	const op = "userservice.LoginWithOTP"
+
+	if err := s.checkOTPRateLimit(ctx, "login", req.PhoneNumber, req.IP, s.otpLoginRateLimit()); err != nil {
+		return param.LoginWithOTPResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if _, err := s.otpClient.VerifyCode(ctx, param.VerifyOTPRequest{
+		PhoneNumber: req.PhoneNumber,
+		Purpose:     entity.OTPPurposeLogin,
+		Code:        req.Code,
+	}); err != nil {
+		return param.LoginWithOTPResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	user, err := s.repo.GetUserByPhoneNumber(req.PhoneNumber)
+	if err != nil {
+		return param.LoginWithOTPResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+	}
+
+	if user.IsBanned() {
+		return param.LoginWithOTPResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgUserIsBanned).
+			WithKind(richerror.KindForbidden).WithMeta(map[string]interface{}{"user_id": user.ID})
+	}
+
+	if !user.IsVerified() {
+		if err := s.repo.VerifyPhoneNumber(ctx, user.ID); err != nil {
+			return param.LoginWithOTPResponse{}, richerror.New(op).WithErr(err).
+				WithMeta(map[string]interface{}{"user_id": user.ID})
+		}
+	}
+
+	tokens, err := s.createTokens(user)
+	if err != nil {
+		return param.LoginWithOTPResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return param.LoginWithOTPResponse{
+		User: param.UserInfo{
+			ID:          user.ID,
+			PhoneNumber: user.PhoneNumber,
+			Name:        user.Name,
+		},
+		Tokens: tokens,
+	}, nil
+}
+
+// otpRateLimit is the number of the requests of an otp action per phone number and per ip in the window.
+type otpRateLimit struct {
+	phoneNumberLimit int64
+	ipLimit          int64
+	window           time.Duration
+}
+
+func (s Service) otpLoginRateLimit() otpRateLimit {
+	return otpRateLimit{
+		phoneNumberLimit: s.config.OTPLoginPhoneNumberLimit,
+		ipLimit:          s.config.OTPLoginIPLimit,
+		window:           s.config.OTPLoginWindow,
+	}
+}
+
+func (s Service) passwordResetRateLimit() otpRateLimit {
+	return otpRateLimit{
+		phoneNumberLimit: s.config.PasswordResetPhoneNumberLimit,
+		ipLimit:          s.config.PasswordResetIPLimit,
+		window:           s.config.PasswordResetWindow,
+	}
+}
+
+func (s Service) verificationCodeRateLimit() otpRateLimit {
+	return otpRateLimit{
+		phoneNumberLimit: s.config.VerificationPhoneNumberLimit,
+		ipLimit:          s.config.VerificationIPLimit,
+		window:           s.config.VerificationWindow,
+	}
+}
+
+// checkOTPRateLimit limits an otp action, e.g. sending a code, per phone number and per ip.
+func (s Service) checkOTPRateLimit(ctx context.Context, action, phoneNumber, ip string, limit otpRateLimit) error {
+	const op = "userservice.checkOTPRateLimit"
+
+	limits := []param.RateLimitRequest{
+		{
+			Key:    fmt.Sprintf("otp:%s:phone_number:%s", action, phoneNumber),
+			Limit:  limit.phoneNumberLimit,
+			Window: limit.window,
+		},
+		{
+			Key:    fmt.Sprintf("otp:%s:ip:%s", action, ip),
+			Limit:  limit.ipLimit,
+			Window: limit.window,
+		},
+	}
+
+	for _, l := range limits {
+		resp, err := s.rateLimiter.Allow(ctx, l)
+		if err != nil {
+			return richerror.New(op).WithErr(err)
+		}
+
+		if !resp.Allowed {
+			return richerror.New(op).WithMessage(errmsg.ErrorMsgTooManyRequests).
+				WithKind(richerror.KindTooManyRequests).
+				WithMeta(map[string]interface{}{"key": l.Key, "retry_after": resp.RetryAfter})
+		}
+	}
+
+	return nil
+}
+
+func (s Service) createTokens(user entity.User) (param.Tokens, error) {
+	accessToken, err := s.auth.CreateAccessToken(user)
+	if err != nil {
+		return param.Tokens{}, err
+	}
+
+	refreshToken, err := s.auth.CreateRefreshToken(user)
+	if err != nil {
+		return param.Tokens{}, err
+	}
+
+	return param.Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
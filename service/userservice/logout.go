@@ -0,0 +1,56 @@
+package userservice
+
+import (
+	"context"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	"gameAppProject/service/authservice"
+)
+
+// Logout revokes the access token of the current session and its refresh token if it's given.
+func (s Service) Logout(ctx context.Context, accessClaims *authservice.Claims, req param.LogoutRequest) (
+	param.LogoutResponse, error) {
+	const op = "userservice.Logout"
+
+	// parse the refresh token before revoking anything, so an invalid request changes nothing
+	var refreshClaims *authservice.Claims
+	if req.RefreshToken != "" {
+		claims, err := s.auth.ParseRefreshToken(req.RefreshToken)
+		if err != nil {
+			return param.LogoutResponse{}, richerror.New(op).WithErr(err)
+		}
+
+		if claims.UserID != accessClaims.UserID {
+			return param.LogoutResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidToken).
+				WithKind(richerror.KindUnauthorized).
+				WithMeta(map[string]interface{}{"user_id": accessClaims.UserID})
+		}
+
+		refreshClaims = claims
+	}
+
+	if err := s.auth.RevokeToken(ctx, accessClaims); err != nil {
+		return param.LogoutResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if refreshClaims != nil {
+		if err := s.auth.RevokeToken(ctx, refreshClaims); err != nil {
+			return param.LogoutResponse{}, richerror.New(op).WithErr(err)
+		}
+	}
+
+	return param.LogoutResponse{}, nil
+}
+
+// LogoutAll revokes every access and refresh token of the user that is issued until now.
+func (s Service) LogoutAll(ctx context.Context, req param.LogoutAllRequest) (param.LogoutAllResponse, error) {
+	const op = "userservice.LogoutAll"
+
+	if err := s.auth.RevokeAllUserTokens(ctx, req.UserID); err != nil {
+		return param.LogoutAllResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	return param.LogoutAllResponse{}, nil
+}
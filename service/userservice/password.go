@@ -0,0 +1,127 @@
+package userservice
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"golang.org/x/crypto/bcrypt"
+	"strings"
+)
+
+// stored passwords look like "<algorithm>$<hash>",
+// legacy md5 hashes were stored without the algorithm prefix.
+const passwordAlgorithmSeparator = "$"
+
+type PasswordHasher interface {
+	Algorithm() string
+	Hash(password string) (string, error)
+	Compare(hash, password string) bool
+}
+
+// PasswordHashers has the hasher of the new passwords and the hashers of the stored passwords by their algorithm,
+// so changing the configured hasher doesn't lock out the users, their passwords are rehashed after a login.
+type PasswordHashers struct {
+	current     PasswordHasher
+	byAlgorithm map[string]PasswordHasher
+	// dummyHash is compared when the user doesn't exist, so the response time doesn't tell
+	// whether a phone number is registered, it's made by the current hasher to take as long as a real one.
+	dummyHash string
+}
+
+func NewPasswordHashers(current PasswordHasher, previous ...PasswordHasher) PasswordHashers {
+	byAlgorithm := make(map[string]PasswordHasher, len(previous)+1)
+	for _, h := range previous {
+		byAlgorithm[h.Algorithm()] = h
+	}
+
+	byAlgorithm[current.Algorithm()] = current
+
+	dummyHash, err := current.Hash("dummy password")
+	if err != nil {
+		panic(fmt.Errorf("can't hash the dummy password: %w", err))
+	}
+
+	return PasswordHashers{current: current, byAlgorithm: byAlgorithm, dummyHash: dummyHash}
+}
+
+type BcryptHasher struct {
+	cost int
+}
+
+func NewBcryptHasher(cost int) BcryptHasher {
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+
+	return BcryptHasher{cost: cost}
+}
+
+func (h BcryptHasher) Algorithm() string {
+	return "bcrypt"
+}
+
+func (h BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+func (h BcryptHasher) Compare(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// NeedsRehash is true if the hash is made with another cost.
+func (h BcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+
+	return err != nil || cost != h.cost
+}
+
+func (s Service) hashPassword(password string) (string, error) {
+	hash, err := s.hashers.current.Hash(password)
+	if err != nil {
+		return "", err
+	}
+
+	return s.hashers.current.Algorithm() + passwordAlgorithmSeparator + hash, nil
+}
+
+// verifyPassword checks the password against the stored value with the hasher that has hashed it,
+// needsRehash is true if the stored value isn't hashed by the current hasher and its settings.
+func (s Service) verifyPassword(stored, password string) (ok bool, needsRehash bool) {
+	algorithm, hash, found := strings.Cut(stored, passwordAlgorithmSeparator)
+	if !found {
+		return compareMD5Hash(stored, password), true
+	}
+
+	hasher, known := s.hashers.byAlgorithm[algorithm]
+	if !known {
+		// TODO - log error, the hasher of the stored passwords must be registered
+		return false, false
+	}
+
+	if !hasher.Compare(hash, password) {
+		return false, false
+	}
+
+	if algorithm != s.hashers.current.Algorithm() {
+		return true, true
+	}
+
+	if r, ok := hasher.(interface{ NeedsRehash(hash string) bool }); ok {
+		return true, r.NeedsRehash(hash)
+	}
+
+	return true, false
+}
+
+// compareMD5Hash only exists to verify legacy passwords, they are rehashed after a successful login.
+func compareMD5Hash(hash, password string) bool {
+	sum := md5.Sum([]byte(password))
+
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(hex.EncodeToString(sum[:]))) == 1
+}
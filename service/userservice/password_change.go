@@ -0,0 +1,137 @@
+package userservice
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+)
+
+// ChangePassword logs out all sessions of the user, including the current one,
+// the attempts of a user are limited, so a stolen session can't guess the old password.
+func (s Service) ChangePassword(ctx context.Context, req param.ChangePasswordRequest) (param.ChangePasswordResponse, error) {
+	const op = "userservice.ChangePassword"
+
+	limit, err := s.rateLimiter.Allow(ctx, param.RateLimitRequest{
+		Key:    fmt.Sprintf("change_password:user:%d", req.UserID),
+		Limit:  s.config.PasswordChangeLimit,
+		Window: s.config.PasswordChangeWindow,
+	})
+	if err != nil {
+		return param.ChangePasswordResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if !limit.Allowed {
+		return param.ChangePasswordResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgTooManyRequests).
+			WithKind(richerror.KindTooManyRequests).
+			WithMeta(map[string]interface{}{"user_id": req.UserID, "retry_after": limit.RetryAfter})
+	}
+
+	user, err := s.repo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return param.ChangePasswordResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if ok, _ := s.verifyPassword(user.Password, req.OldPassword); !ok {
+		return param.ChangePasswordResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgOldPasswordIsNotCorrect).
+			WithKind(richerror.KindInvalid).WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	if err := s.setPassword(ctx, user.ID, req.NewPassword); err != nil {
+		return param.ChangePasswordResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	return param.ChangePasswordResponse{}, nil
+}
+
+// SendResetPasswordCode sends a code to the registered phone numbers,
+// the response is the same in all cases, so it doesn't tell whether a phone number is registered.
+func (s Service) SendResetPasswordCode(ctx context.Context, req param.SendResetPasswordCodeRequest) (
+	param.SendResetPasswordCodeResponse, error) {
+	const op = "userservice.SendResetPasswordCode"
+
+	if err := s.checkOTPRateLimit(ctx, "send_reset_password_code", req.PhoneNumber, req.IP, s.passwordResetRateLimit()); err != nil {
+		return param.SendResetPasswordCodeResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	_, err := s.repo.GetUserByPhoneNumber(req.PhoneNumber)
+	if err != nil {
+		if re, ok := err.(richerror.RichError); ok && re.Kind() == richerror.KindNotFound {
+			return param.SendResetPasswordCodeResponse{}, nil
+		}
+
+		return param.SendResetPasswordCodeResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+	}
+
+	if _, err := s.otpClient.SendCode(ctx, param.SendOTPRequest{
+		PhoneNumber: req.PhoneNumber,
+		Purpose:     entity.OTPPurposeResetPassword,
+	}); err != nil {
+		return param.SendResetPasswordCodeResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	return param.SendResetPasswordCodeResponse{}, nil
+}
+
+// ResetPassword sets the password by the code that is sent to the phone number,
+// all sessions of the user are logged out.
+func (s Service) ResetPassword(ctx context.Context, req param.ResetPasswordRequest) (param.ResetPasswordResponse, error) {
+	const op = "userservice.ResetPassword"
+
+	if err := s.checkOTPRateLimit(ctx, "reset_password", req.PhoneNumber, req.IP, s.passwordResetRateLimit()); err != nil {
+		return param.ResetPasswordResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if _, err := s.otpClient.VerifyCode(ctx, param.VerifyOTPRequest{
+		PhoneNumber: req.PhoneNumber,
+		Purpose:     entity.OTPPurposeResetPassword,
+		Code:        req.Code,
+	}); err != nil {
+		return param.ResetPasswordResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	user, err := s.repo.GetUserByPhoneNumber(req.PhoneNumber)
+	if err != nil {
+		return param.ResetPasswordResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+	}
+
+	if err := s.setPassword(ctx, user.ID, req.NewPassword); err != nil {
+		return param.ResetPasswordResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	// the code proves the phone number too
+	if !user.IsVerified() {
+		if err := s.repo.VerifyPhoneNumber(ctx, user.ID); err != nil {
+			return param.ResetPasswordResponse{}, richerror.New(op).WithErr(err).
+				WithMeta(map[string]interface{}{"user_id": user.ID})
+		}
+	}
+
+	return param.ResetPasswordResponse{}, nil
+}
+
+// setPassword revokes the sessions before the password is updated,
+// so a failure never leaves the new password with the old sessions still valid.
+func (s Service) setPassword(ctx context.Context, userID uint, password string) error {
+	const op = "userservice.setPassword"
+
+	hashedPassword, err := s.hashPassword(password)
+	if err != nil {
+		return richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	if err := s.auth.RevokeAllUserTokens(ctx, userID); err != nil {
+		return richerror.New(op).WithErr(err).WithMeta(map[string]interface{}{"user_id": userID})
+	}
+
+	if err := s.repo.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+		return richerror.New(op).WithErr(err).WithMeta(map[string]interface{}{"user_id": userID})
+	}
+
+	return nil
+}
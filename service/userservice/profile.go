@@ -17,5 +17,28 @@ func (s Service) Profile(ctx context.Context, req param.ProfileRequest) (param.P
 			WithMeta(map[string]interface{}{"req": req})
 	}
 
-	return param.ProfileResponse{Name: user.Name}, nil
+	stats, err := s.statsClient.GetUserStats(ctx, param.GetUserStatsRequest{UserID: req.UserID})
+	if err != nil {
+		return param.ProfileResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"req": req})
+	}
+
+	resp := param.ProfileResponse{Name: user.Name, Stats: stats.Items}
+	if user.Avatar != "" {
+		resp.Avatar = s.avatarStorage.URL(user.Avatar)
+		resp.AvatarThumbnail = s.avatarStorage.URL(avatarThumbnailKey(user.Avatar))
+	}
+
+	return resp, nil
+}
+
+func (s Service) UpdateProfile(ctx context.Context, req param.UpdateProfileRequest) (param.UpdateProfileResponse, error) {
+	const op = "userservice.UpdateProfile"
+
+	if err := s.repo.UpdateName(ctx, req.UserID, req.Name); err != nil {
+		return param.UpdateProfileResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": req.UserID})
+	}
+
+	return param.UpdateProfileResponse{Name: req.Name}, nil
 }
@@ -0,0 +1,49 @@
+package userservice
+
+import (
+	"context"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+)
+
+// RefreshToken rotates the refresh token, the given token can't be used again.
+func (s Service) RefreshToken(ctx context.Context, req param.RefreshTokenRequest) (param.RefreshTokenResponse, error) {
+	const op = "userservice.RefreshToken"
+
+	claims, err := s.auth.ParseRefreshToken(req.RefreshToken)
+	if err != nil {
+		return param.RefreshTokenResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if err := s.auth.ConsumeRefreshToken(ctx, claims); err != nil {
+		return param.RefreshTokenResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	// load the user again, the role may have been changed since the token was issued
+	user, err := s.repo.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		return param.RefreshTokenResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": claims.UserID})
+	}
+
+	if user.IsBanned() {
+		return param.RefreshTokenResponse{}, richerror.New(op).WithMessage(errmsg.ErrorMsgUserIsBanned).
+			WithKind(richerror.KindForbidden).WithMeta(map[string]interface{}{"user_id": user.ID})
+	}
+
+	accessToken, err := s.auth.CreateAccessToken(user)
+	if err != nil {
+		return param.RefreshTokenResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	refreshToken, err := s.auth.CreateRefreshToken(user)
+	if err != nil {
+		return param.RefreshTokenResponse{}, richerror.New(op).WithErr(err).WithKind(richerror.KindUnexpected)
+	}
+
+	return param.RefreshTokenResponse{Tokens: param.Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}}, nil
+}
@@ -1,20 +1,23 @@
 package userservice
 
 import (
+	"context"
 	"fmt"
 	"gameAppProject/entity"
 	"gameAppProject/param"
 )
 
 func (s Service) Register(req param.RegisterRequest) (param.RegisterResponse, error) {
-	// TODO - we should verify phone number by verification code
+	hashedPassword, err := s.hashPassword(req.Password)
+	if err != nil {
+		return param.RegisterResponse{}, fmt.Errorf("unexpected error: %w", err)
+	}
 
-	// TODO - replace md5 with bcrypt
 	user := entity.User{
 		ID:          0,
 		PhoneNumber: req.PhoneNumber,
 		Name:        req.Name,
-		Password:    getMD5Hash(req.Password),
+		Password:    hashedPassword,
 		Role:        entity.UserRole,
 	}
 
@@ -24,6 +27,9 @@ func (s Service) Register(req param.RegisterRequest) (param.RegisterResponse, er
 		return param.RegisterResponse{}, fmt.Errorf("unexpected error: %w", err)
 	}
 
+	// the user can't log in until the phone number is verified
+	s.sendVerificationCode(context.Background(), createdUser.PhoneNumber)
+
 	// return created user
 	return param.RegisterResponse{User: param.UserInfo{
 		ID:          createdUser.ID,
@@ -2,32 +2,93 @@ package userservice
 
 import (
 	"context"
-	"crypto/md5"
-	"encoding/hex"
 	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/service/authservice"
+	"time"
 )
 
 type Repository interface {
 	Register(u entity.User) (entity.User, error)
 	GetUserByPhoneNumber(phoneNumber string) (entity.User, error)
 	GetUserByID(ctx context.Context, userID uint) (entity.User, error)
+	UpdatePassword(ctx context.Context, userID uint, hashedPassword string) error
+	VerifyPhoneNumber(ctx context.Context, userID uint) error
+	UpdateName(ctx context.Context, userID uint, name string) error
+	UpdateAvatar(ctx context.Context, userID uint, avatar string) error
 }
 
 type AuthGenerator interface {
 	CreateAccessToken(user entity.User) (string, error)
 	CreateRefreshToken(user entity.User) (string, error)
+	ParseRefreshToken(token string) (*authservice.Claims, error)
+	ConsumeRefreshToken(ctx context.Context, claims *authservice.Claims) error
+	RevokeToken(ctx context.Context, claims *authservice.Claims) error
+	RevokeAllUserTokens(ctx context.Context, userID uint) error
 }
 
-type Service struct {
-	auth AuthGenerator
-	repo Repository
+type OTPClient interface {
+	SendCode(ctx context.Context, req param.SendOTPRequest) (param.SendOTPResponse, error)
+	VerifyCode(ctx context.Context, req param.VerifyOTPRequest) (param.VerifyOTPResponse, error)
+}
+
+type RateLimiter interface {
+	Allow(ctx context.Context, req param.RateLimitRequest) (param.RateLimitResponse, error)
+}
+
+type LoginGuard interface {
+	CheckLogin(ctx context.Context, req param.CheckLoginRequest) (param.CheckLoginResponse, error)
+	LoginFailed(ctx context.Context, req param.LoginFailedRequest) (param.LoginFailedResponse, error)
+	LoginSucceeded(ctx context.Context, req param.LoginSucceededRequest) (param.LoginSucceededResponse, error)
+}
+
+type AvatarStorage interface {
+	Save(ctx context.Context, key string, data []byte) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+type StatsClient interface {
+	GetUserStats(ctx context.Context, req param.GetUserStatsRequest) (param.GetUserStatsResponse, error)
 }
 
-func New(authGenerator AuthGenerator, repo Repository) Service {
-	return Service{auth: authGenerator, repo: repo}
+type Config struct {
+	BcryptCost int `koanf:"bcrypt_cost"`
+	// the code requests and the otp attempts are limited per phone number and per ip in the window
+	OTPLoginPhoneNumberLimit int64         `koanf:"otp_login_phone_number_limit"`
+	OTPLoginIPLimit          int64         `koanf:"otp_login_ip_limit"`
+	OTPLoginWindow           time.Duration `koanf:"otp_login_window"`
+	// the verification code requests are limited separately, they send an sms too
+	VerificationPhoneNumberLimit int64         `koanf:"verification_phone_number_limit"`
+	VerificationIPLimit          int64         `koanf:"verification_ip_limit"`
+	VerificationWindow           time.Duration `koanf:"verification_window"`
+	// the reset password codes and attempts are limited separately from the login ones
+	PasswordResetPhoneNumberLimit int64         `koanf:"password_reset_phone_number_limit"`
+	PasswordResetIPLimit          int64         `koanf:"password_reset_ip_limit"`
+	PasswordResetWindow           time.Duration `koanf:"password_reset_window"`
+	// the old password attempts of a user are limited in the window
+	PasswordChangeLimit  int64         `koanf:"password_change_limit"`
+	PasswordChangeWindow time.Duration `koanf:"password_change_window"`
+	// AvatarMaxSize is in bytes, AvatarMaxDimension and AvatarThumbnailSize are in pixels
+	AvatarMaxSize       int64 `koanf:"avatar_max_size"`
+	AvatarMaxDimension  int   `koanf:"avatar_max_dimension"`
+	AvatarThumbnailSize int   `koanf:"avatar_thumbnail_size"`
+}
+
+type Service struct {
+	config        Config
+	auth          AuthGenerator
+	repo          Repository
+	hashers       PasswordHashers
+	otpClient     OTPClient
+	rateLimiter   RateLimiter
+	loginGuard    LoginGuard
+	avatarStorage AvatarStorage
+	statsClient   StatsClient
 }
 
-func getMD5Hash(text string) string {
-	hash := md5.Sum([]byte(text))
-	return hex.EncodeToString(hash[:])
+func New(config Config, authGenerator AuthGenerator, repo Repository, hashers PasswordHashers, otpClient OTPClient,
+	rateLimiter RateLimiter, loginGuard LoginGuard, avatarStorage AvatarStorage, statsClient StatsClient) Service {
+	return Service{config: config, auth: authGenerator, repo: repo, hashers: hashers, otpClient: otpClient,
+		rateLimiter: rateLimiter, loginGuard: loginGuard, avatarStorage: avatarStorage, statsClient: statsClient}
 }
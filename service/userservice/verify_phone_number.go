@@ -0,0 +1,85 @@
+package userservice
+
+import (
+	"context"
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/richerror"
+)
+
+// SendVerificationCode sends a code only to the registered phone numbers that are not verified yet,
+// the response is the same in all cases, so it doesn't tell whether a phone number is registered.
+func (s Service) SendVerificationCode(ctx context.Context, req param.SendVerificationCodeRequest) (
+	param.SendVerificationCodeResponse, error) {
+	const op = "userservice.SendVerificationCode"
+
+	if err := s.checkOTPRateLimit(ctx, "send_verification_code", req.PhoneNumber, req.IP,
+		s.verificationCodeRateLimit()); err != nil {
+		return param.SendVerificationCodeResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	user, err := s.repo.GetUserByPhoneNumber(req.PhoneNumber)
+	if err != nil {
+		if re, ok := err.(richerror.RichError); ok && re.Kind() == richerror.KindNotFound {
+			return param.SendVerificationCodeResponse{}, nil
+		}
+
+		return param.SendVerificationCodeResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+	}
+
+	if user.IsVerified() {
+		return param.SendVerificationCodeResponse{}, nil
+	}
+
+	if _, err := s.otpClient.SendCode(ctx, param.SendOTPRequest{
+		PhoneNumber: req.PhoneNumber,
+		Purpose:     entity.OTPPurposeVerifyPhoneNumber,
+	}); err != nil {
+		return param.SendVerificationCodeResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	return param.SendVerificationCodeResponse{}, nil
+}
+
+func (s Service) VerifyPhoneNumber(ctx context.Context, req param.VerifyPhoneNumberRequest) (
+	param.VerifyPhoneNumberResponse, error) {
+	const op = "userservice.VerifyPhoneNumber"
+
+	if err := s.checkOTPRateLimit(ctx, "verify_phone_number", req.PhoneNumber, req.IP,
+		s.verificationCodeRateLimit()); err != nil {
+		return param.VerifyPhoneNumberResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	if _, err := s.otpClient.VerifyCode(ctx, param.VerifyOTPRequest{
+		PhoneNumber: req.PhoneNumber,
+		Purpose:     entity.OTPPurposeVerifyPhoneNumber,
+		Code:        req.Code,
+	}); err != nil {
+		return param.VerifyPhoneNumberResponse{}, richerror.New(op).WithErr(err)
+	}
+
+	user, err := s.repo.GetUserByPhoneNumber(req.PhoneNumber)
+	if err != nil {
+		return param.VerifyPhoneNumberResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber})
+	}
+
+	if err := s.repo.VerifyPhoneNumber(ctx, user.ID); err != nil {
+		return param.VerifyPhoneNumberResponse{}, richerror.New(op).WithErr(err).
+			WithMeta(map[string]interface{}{"user_id": user.ID})
+	}
+
+	return param.VerifyPhoneNumberResponse{}, nil
+}
+
+func (s Service) sendVerificationCode(ctx context.Context, phoneNumber string) {
+	if _, err := s.otpClient.SendCode(ctx, param.SendOTPRequest{
+		PhoneNumber: phoneNumber,
+		Purpose:     entity.OTPPurposeVerifyPhoneNumber,
+	}); err != nil {
+		// TODO - log error, the user can request a new code
+		fmt.Println("otpClient.SendCode error", err)
+	}
+}
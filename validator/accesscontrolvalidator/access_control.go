@@ -0,0 +1,69 @@
+package accesscontrolvalidator
+
+import (
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v Validator) ValidateGrantRequest(req param.AccessControlGrantRequest) (map[string]string, error) {
+	const op = "accesscontrolvalidator.ValidateGrantRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.ActorType, validation.Required, validation.By(v.isActorTypeValid)),
+
+		// role actors are kept by the role id, e.g. 2 for admins
+		validation.Field(&req.ActorID, validation.Required,
+			validation.When(req.ActorType == entity.RoleActorType, validation.By(v.isRoleIDValid))),
+
+		validation.Field(&req.PermissionID, validation.Required),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
+
+func (v Validator) ValidateListRequest(req param.AccessControlListRequest) (map[string]string, error) {
+	const op = "accesscontrolvalidator.ValidateListRequest"
+
+	if err := validation.ValidateStruct(&req,
+		// the actor filter is optional, but both fields are needed to use it
+		validation.Field(&req.ActorType, validation.When(req.ActorType != "" || req.ActorID != 0,
+			validation.Required, validation.By(v.isActorTypeValid))),
+
+		validation.Field(&req.ActorID, validation.When(req.ActorType != "", validation.Required)),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
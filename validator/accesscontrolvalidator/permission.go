@@ -0,0 +1,55 @@
+package accesscontrolvalidator
+
+import (
+	"fmt"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"regexp"
+)
+
+func (v Validator) ValidateCreatePermissionRequest(req param.PermissionCreateRequest) (map[string]string, error) {
+	const op = "accesscontrolvalidator.ValidateCreatePermissionRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.Title,
+			validation.Required,
+			validation.Length(3, 191),
+			validation.Match(regexp.MustCompile(permissionTitleRegex)).Error(errmsg.ErrorMsgPermissionTitleIsNotValid),
+			validation.By(v.checkPermissionTitleUniqueness)),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
+
+func (v Validator) checkPermissionTitleUniqueness(value interface{}) error {
+	title := value.(string)
+
+	if isUnique, err := v.repo.IsPermissionTitleUnique(title); err != nil || !isUnique {
+		if err != nil {
+			return err
+		}
+
+		if !isUnique {
+			return fmt.Errorf(errmsg.ErrorMsgPermissionTitleIsNotUnique)
+		}
+	}
+
+	return nil
+}
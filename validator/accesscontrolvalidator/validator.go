@@ -0,0 +1,43 @@
+package accesscontrolvalidator
+
+import (
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+)
+
+const (
+	permissionTitleRegex = "^[a-z0-9]+(-[a-z0-9]+)*$"
+)
+
+type Repository interface {
+	IsPermissionTitleUnique(title string) (bool, error)
+}
+
+type Validator struct {
+	repo Repository
+}
+
+func New(repo Repository) Validator {
+	return Validator{repo: repo}
+}
+
+func (v Validator) isActorTypeValid(value interface{}) error {
+	actorType := value.(entity.ActorType)
+
+	if !actorType.IsValid() {
+		return fmt.Errorf(errmsg.ErrorMsgActorTypeIsNotValid)
+	}
+
+	return nil
+}
+
+func (v Validator) isRoleIDValid(value interface{}) error {
+	roleID := value.(uint)
+
+	if entity.Role(roleID).String() == "" {
+		return fmt.Errorf(errmsg.ErrorMsgRoleIsNotValid)
+	}
+
+	return nil
+}
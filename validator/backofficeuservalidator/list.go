@@ -0,0 +1,42 @@
+package backofficeuservalidator
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v Validator) ValidateListUsersRequest(req param.BackofficeUserListRequest) (map[string]string, error) {
+	const op = "backofficeuservalidator.ValidateListUsersRequest"
+
+	if err := validation.ValidateStruct(&req,
+		// the filters and the sort are optional
+		validation.Field(&req.Role, validation.When(req.Role != "", validation.By(v.isRoleValid))),
+
+		validation.Field(&req.SortBy, validation.When(req.SortBy != "", validation.By(v.isSortFieldValid))),
+
+		validation.Field(&req.SortOrder, validation.In("asc", "desc")),
+
+		validation.Field(&req.PageSize, validation.Max(uint(maxPageSize))),
+
+		validation.Field(&req.Page, validation.Max(uint(maxPage))),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,33 @@
+package backofficeuservalidator
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v Validator) ValidateChangeRoleRequest(req param.ChangeRoleRequest) (map[string]string, error) {
+	const op = "backofficeuservalidator.ValidateChangeRoleRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.Role, validation.Required, validation.By(v.isRoleValid)),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
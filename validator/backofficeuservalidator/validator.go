@@ -0,0 +1,39 @@
+package backofficeuservalidator
+
+import (
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/pkg/errmsg"
+)
+
+const (
+	maxPageSize = 100
+	// maxPage keeps the offset of the page, (page-1)*pageSize, from overflowing
+	maxPage = 10000
+)
+
+type Validator struct{}
+
+func New() Validator {
+	return Validator{}
+}
+
+func (v Validator) isRoleValid(value interface{}) error {
+	role := value.(string)
+
+	if entity.MapToRoleEntity(role) == 0 {
+		return fmt.Errorf(errmsg.ErrorMsgRoleIsNotValid)
+	}
+
+	return nil
+}
+
+func (v Validator) isSortFieldValid(value interface{}) error {
+	field := value.(string)
+
+	if !entity.UserSortField(field).IsValid() {
+		return fmt.Errorf(errmsg.ErrorMsgSortFieldIsNotValid)
+	}
+
+	return nil
+}
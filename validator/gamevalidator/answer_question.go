@@ -0,0 +1,51 @@
+package gamevalidator
+
+import (
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v Validator) ValidateAnswerQuestionRequest(req param.AnswerQuestionRequest) (map[string]string, error) {
+	const op = "gamevalidator.ValidateAnswerQuestionRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.GameID, validation.Required),
+
+		validation.Field(&req.QuestionID, validation.Required),
+
+		validation.Field(&req.Choice,
+			validation.Required,
+			validation.By(v.isChoiceValid)),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
+
+func (v Validator) isChoiceValid(value interface{}) error {
+	choice := value.(entity.PossibleAnswerChoice)
+
+	if !choice.IsValid() {
+		return fmt.Errorf(errmsg.ErrorMsgChoiceIsNotValid)
+	}
+
+	return nil
+}
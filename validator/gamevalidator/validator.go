@@ -0,0 +1,7 @@
+package gamevalidator
+
+type Validator struct{}
+
+func New() Validator {
+	return Validator{}
+}
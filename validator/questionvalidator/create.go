@@ -0,0 +1,52 @@
+package questionvalidator
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v Validator) ValidateCreateQuestionRequest(req param.QuestionCreateRequest) (map[string]string, error) {
+	const op = "questionvalidator.ValidateCreateQuestionRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.Text,
+			validation.Required,
+			validation.Length(3, 1000)),
+
+		validation.Field(&req.PossibleAnswers,
+			validation.Required,
+			validation.Length(minPossibleAnswers, maxPossibleAnswers),
+			validation.By(v.arePossibleAnswersValid)),
+
+		validation.Field(&req.CorrectAnswer,
+			validation.Required,
+			validation.By(v.isCorrectAnswerValid(req.PossibleAnswers))),
+
+		validation.Field(&req.Difficulty,
+			validation.Required,
+			validation.By(v.isDifficultyValid)),
+
+		validation.Field(&req.Category,
+			validation.Required,
+			validation.By(v.isCategoryValid)),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,36 @@
+package questionvalidator
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v Validator) ValidateListQuestionRequest(req param.QuestionListRequest) (map[string]string, error) {
+	const op = "questionvalidator.ValidateListQuestionRequest"
+
+	if err := validation.ValidateStruct(&req,
+		// category is optional, an empty category lists all categories
+		validation.Field(&req.Category, validation.When(req.Category != "", validation.By(v.isCategoryValid))),
+
+		validation.Field(&req.PageSize, validation.Max(uint(maxPageSize))),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,69 @@
+package questionvalidator
+
+import (
+	"fmt"
+	"gameAppProject/entity"
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+)
+
+const (
+	minPossibleAnswers = 2
+	maxPossibleAnswers = 4
+	maxPageSize        = 100
+)
+
+type Validator struct{}
+
+func New() Validator {
+	return Validator{}
+}
+
+func (v Validator) isCategoryValid(value interface{}) error {
+	category := value.(entity.Category)
+
+	if !category.IsValid() {
+		return fmt.Errorf(errmsg.ErrorMsgCategoryIsNotValid)
+	}
+
+	return nil
+}
+
+func (v Validator) isDifficultyValid(value interface{}) error {
+	difficulty := value.(entity.QuestionDifficulty)
+
+	if !difficulty.IsValid() {
+		return fmt.Errorf(errmsg.ErrorMsgDifficultyIsNotValid)
+	}
+
+	return nil
+}
+
+func (v Validator) arePossibleAnswersValid(value interface{}) error {
+	answers := value.([]param.PossibleAnswerInfo)
+
+	seen := make(map[entity.PossibleAnswerChoice]bool)
+	for _, a := range answers {
+		if a.Text == "" || !a.Choice.IsValid() || seen[a.Choice] {
+			return fmt.Errorf(errmsg.ErrorMsgPossibleAnswersInvalid)
+		}
+
+		seen[a.Choice] = true
+	}
+
+	return nil
+}
+
+func (v Validator) isCorrectAnswerValid(answers []param.PossibleAnswerInfo) func(value interface{}) error {
+	return func(value interface{}) error {
+		correctAnswer := value.(entity.PossibleAnswerChoice)
+
+		for _, a := range answers {
+			if a.Choice == correctAnswer {
+				return nil
+			}
+		}
+
+		return fmt.Errorf(errmsg.ErrorMsgCorrectAnswerIsInvalid)
+	}
+}
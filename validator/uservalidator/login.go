@@ -1,7 +1,6 @@
 package uservalidator
 
 import (
-	"fmt"
 	"gameAppProject/param"
 	"gameAppProject/pkg/errmsg"
 	"gameAppProject/pkg/richerror"
@@ -16,8 +15,7 @@ func (v Validator) ValidateLoginRequest(req param.LoginRequest) (map[string]stri
 
 		validation.Field(&req.PhoneNumber,
 			validation.Required,
-			validation.Match(regexp.MustCompile(phoneNumberRegex)).Error(errmsg.ErrorMsgPhoneNumberIsNotValid),
-			validation.By(v.doesPhoneNumberExist)),
+			validation.Match(regexp.MustCompile(phoneNumberRegex)).Error(errmsg.ErrorMsgPhoneNumberIsNotValid)),
 
 		validation.Field(&req.Password, validation.Required),
 	); err != nil {
@@ -39,13 +37,3 @@ func (v Validator) ValidateLoginRequest(req param.LoginRequest) (map[string]stri
 
 	return nil, nil
 }
-
-func (v Validator) doesPhoneNumberExist(value interface{}) error {
-	phoneNumber := value.(string)
-	_, err := v.repo.GetUserByPhoneNumber(phoneNumber)
-	if err != nil {
-		return fmt.Errorf(errmsg.ErrorMsgNotFound)
-	}
-
-	return nil
-}
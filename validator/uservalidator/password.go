@@ -0,0 +1,101 @@
+package uservalidator
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"regexp"
+)
+
+func (v Validator) ValidateChangePasswordRequest(req param.ChangePasswordRequest) (map[string]string, error) {
+	const op = "uservalidator.ValidateChangePasswordRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.OldPassword, validation.Required),
+
+		validation.Field(&req.NewPassword,
+			validation.Required,
+			validation.Match(regexp.MustCompile(passwordRegex)),
+			validation.NotIn(req.OldPassword).Error(errmsg.ErrorMsgNewPasswordIsTheSame)),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"user_id": req.UserID}).WithErr(err)
+	}
+
+	return nil, nil
+}
+
+func (v Validator) ValidateSendResetPasswordCodeRequest(req param.SendResetPasswordCodeRequest) (map[string]string, error) {
+	const op = "uservalidator.ValidateSendResetPasswordCodeRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.PhoneNumber,
+			validation.Required,
+			validation.Match(regexp.MustCompile(phoneNumberRegex)).Error(errmsg.ErrorMsgPhoneNumberIsNotValid)),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
+
+func (v Validator) ValidateResetPasswordRequest(req param.ResetPasswordRequest) (map[string]string, error) {
+	const op = "uservalidator.ValidateResetPasswordRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.PhoneNumber,
+			validation.Required,
+			validation.Match(regexp.MustCompile(phoneNumberRegex)).Error(errmsg.ErrorMsgPhoneNumberIsNotValid)),
+
+		validation.Field(&req.Code,
+			validation.Required,
+			validation.Match(regexp.MustCompile(otpCodeRegex))),
+
+		validation.Field(&req.NewPassword,
+			validation.Required,
+			validation.Match(regexp.MustCompile(passwordRegex))),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"phone_number": req.PhoneNumber}).WithErr(err)
+	}
+
+	return nil, nil
+}
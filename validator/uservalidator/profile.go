@@ -0,0 +1,36 @@
+package uservalidator
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v Validator) ValidateUpdateProfileRequest(req param.UpdateProfileRequest) (map[string]string, error) {
+	const op = "uservalidator.ValidateUpdateProfileRequest"
+
+	if err := validation.ValidateStruct(&req,
+		// TODO - add 3 to config
+		validation.Field(&req.Name,
+			validation.Required,
+			validation.Length(3, 50)),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
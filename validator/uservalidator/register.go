@@ -20,7 +20,7 @@ func (v Validator) ValidateRegisterRequest(req param.RegisterRequest) (map[strin
 
 		validation.Field(&req.Password,
 			validation.Required,
-			validation.Match(regexp.MustCompile(`^[A-Za-z0-9!@#%^&*]{8,}$`))),
+			validation.Match(regexp.MustCompile(passwordRegex))),
 
 		validation.Field(&req.PhoneNumber,
 			validation.Required,
@@ -1,14 +1,14 @@
 package uservalidator
 
-import "gameAppProject/entity"
-
 const (
 	phoneNumberRegex = "^09[0-9]{9}$"
+	otpCodeRegex     = "^[0-9]{4,8}$"
+	// bcrypt doesn't hash more than 72 bytes, the allowed characters are one byte each
+	passwordRegex = `^[A-Za-z0-9!@#%^&*]{8,72}$`
 )
 
 type Repository interface {
 	IsPhoneNumberUnique(phoneNumber string) (bool, error)
-	GetUserByPhoneNumber(phoneNumber string) (entity.User, error)
 }
 
 type Validator struct {
@@ -0,0 +1,67 @@
+package uservalidator
+
+import (
+	"gameAppProject/param"
+	"gameAppProject/pkg/errmsg"
+	"gameAppProject/pkg/richerror"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"regexp"
+)
+
+func (v Validator) ValidateSendVerificationCodeRequest(req param.SendVerificationCodeRequest) (map[string]string, error) {
+	const op = "uservalidator.ValidateSendVerificationCodeRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.PhoneNumber,
+			validation.Required,
+			validation.Match(regexp.MustCompile(phoneNumberRegex)).Error(errmsg.ErrorMsgPhoneNumberIsNotValid)),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}
+
+func (v Validator) ValidateVerifyPhoneNumberRequest(req param.VerifyPhoneNumberRequest) (map[string]string, error) {
+	const op = "uservalidator.ValidateVerifyPhoneNumberRequest"
+
+	if err := validation.ValidateStruct(&req,
+		validation.Field(&req.PhoneNumber,
+			validation.Required,
+			validation.Match(regexp.MustCompile(phoneNumberRegex)).Error(errmsg.ErrorMsgPhoneNumberIsNotValid)),
+
+		validation.Field(&req.Code,
+			validation.Required,
+			validation.Match(regexp.MustCompile(otpCodeRegex))),
+	); err != nil {
+		fieldErrors := make(map[string]string)
+
+		errV, ok := err.(validation.Errors)
+		if ok {
+			for key, value := range errV {
+				if value != nil {
+					fieldErrors[key] = value.Error()
+				}
+			}
+		}
+
+		return fieldErrors, richerror.New(op).WithMessage(errmsg.ErrorMsgInvalidInput).
+			WithKind(richerror.KindInvalid).
+			WithMeta(map[string]interface{}{"req": req}).WithErr(err)
+	}
+
+	return nil, nil
+}